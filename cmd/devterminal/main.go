@@ -1,6 +1,7 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"os"
 
@@ -10,8 +11,27 @@ import (
 )
 
 func main() {
-	p := tea.NewProgram(ui.NewMainModel(), tea.WithAltScreen())
-	if _, err := p.Run(); err != nil {
+	// devterminal sbom ...: arayüz açmadan SBOM üretir
+	if len(os.Args) > 1 && os.Args[1] == "sbom" {
+		os.Exit(runSBOM(os.Args[2:]))
+	}
+
+	// --dry-run: başlatma komutları çalıştırılmaz, genişletilmiş hali ve argv önizlenir
+	dryRun := flag.Bool("dry-run", false, "başlatma komutlarını çalıştırmadan önizle")
+	// --offline: Dependency Doctor ağa çıkmadan kilit dosyalarını manifestle karşılaştırır
+	offline := flag.Bool("offline", false, "bağımlılık doktorunu offline (kilit dosyası) modunda aç")
+	flag.Parse()
+
+	m := ui.NewMainModel()
+	m.DryRun = *dryRun
+	if *offline {
+		m.DoctorOffline = true
+	}
+	p := tea.NewProgram(m, tea.WithAltScreen())
+	_, err := p.Run()
+	// Gömülü süreçleri arkada sahipsiz bırakma
+	m.Shutdown()
+	if err != nil {
 		fmt.Printf("Alas, there's been an error: %v", err)
 		os.Exit(1)
 	}
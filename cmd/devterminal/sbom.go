@@ -0,0 +1,137 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"devterminal/pkg/config"
+	"devterminal/pkg/domain"
+	"devterminal/pkg/service"
+)
+
+// runSBOM "devterminal sbom [--format cyclonedx|spdx] [-o dosya] [proje yolu veya adı]" komutudur.
+// Arayüz açılmaz; SBOM standart çıktıya (veya -o ile dosyaya) yazılır, uyarılar stderr'e gider.
+func runSBOM(args []string) int {
+	fs := flag.NewFlagSet("sbom", flag.ContinueOnError)
+	format := fs.String("format", string(service.SBOMCycloneDX), "çıktı biçimi: cyclonedx (1.5) veya spdx (2.3)")
+	output := fs.String("o", "", "çıktı dosyası (boşsa standart çıktı)")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Kullanım: devterminal sbom [--format cyclonedx|spdx] [-o dosya] [proje yolu veya adı]")
+		fs.PrintDefaults()
+	}
+	positional, err := parseInterspersed(fs, args)
+	if errors.Is(err, flag.ErrHelp) {
+		return 0
+	}
+	if err != nil {
+		// Hata ve kullanım bilgisini flag paketi zaten yazdı
+		return 2
+	}
+	if len(positional) > 1 {
+		fmt.Fprintf(os.Stderr, "fazla argüman: %s (tek bir proje yolu veya adı verin)\n", strings.Join(positional[1:], " "))
+		fs.Usage()
+		return 2
+	}
+
+	f := service.SBOMFormat(strings.ToLower(*format))
+	if f != service.SBOMCycloneDX && f != service.SBOMSPDX {
+		fmt.Fprintf(os.Stderr, "bilinmeyen biçim: %s (cyclonedx veya spdx)\n", *format)
+		return 2
+	}
+	target := "."
+	if len(positional) > 0 {
+		target = positional[0]
+	}
+
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "config okunamadı:", err)
+		return 1
+	}
+	p, err := resolveProject(cfg, target)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	sbom, err := service.NewDoctor(cfg).BuildSBOM(&p)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "SBOM oluşturulamadı:", err)
+		return 1
+	}
+	for _, e := range sbom.Errors {
+		fmt.Fprintln(os.Stderr, "uyarı:", e)
+	}
+
+	if *output == "" {
+		if err := sbom.Write(os.Stdout, f); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			return 1
+		}
+		return 0
+	}
+	if err := writeSBOMFile(sbom, f, *output); err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		return 1
+	}
+	fmt.Fprintf(os.Stderr, "%s: %d bileşen, %d lisans okundu -> %s\n", p.Name, len(sbom.Components), sbom.Licensed(), *output)
+	return 0
+}
+
+// parseInterspersed bayrakları konumsal argümanlardan sonra da okur
+// ("devterminal sbom ./app -o sbom.json"); "--" sonrası her şey konumsaldır
+func parseInterspersed(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return nil, err
+		}
+		rest := fs.Args()
+		if consumed := args[:len(args)-len(rest)]; len(consumed) > 0 && consumed[len(consumed)-1] == "--" {
+			return append(positional, rest...), nil
+		}
+		if len(rest) == 0 {
+			return positional, nil
+		}
+		positional = append(positional, rest[0])
+		args = rest[1:]
+	}
+}
+
+// writeSBOMFile SBOM'u dosyaya yazar; diske yazılamayan veri Close'da ortaya çıkabildiği için
+// kapatma hatası da döner
+func writeSBOMFile(sbom *service.SBOM, f service.SBOMFormat, path string) error {
+	file, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := sbom.Write(file, f); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
+
+// resolveProject hedef bir klasörse onu taranan projelerdeki gibi çözer (frontend/backend klasörleri dahil),
+// değilse projects_paths altındaki projelerde adıyla arar
+func resolveProject(cfg *domain.Config, target string) (domain.Project, error) {
+	scanner := service.NewScanner(cfg)
+	if info, err := os.Stat(target); err == nil && info.IsDir() {
+		abs, err := filepath.Abs(target)
+		if err != nil {
+			return domain.Project{}, err
+		}
+		// Proje olarak algılanmasa da kök klasördeki manifestler okunur
+		p, _ := scanner.ScanProject(abs)
+		return p, nil
+	}
+	for _, p := range scanner.ScanProjects() {
+		if strings.EqualFold(p.Name, target) {
+			return p, nil
+		}
+	}
+	return domain.Project{}, fmt.Errorf("proje bulunamadı: %s (klasör yolu veya projects_paths altındaki bir proje adı verin)", target)
+}
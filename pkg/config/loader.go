@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"runtime"
 	"strings"
 
 	"devterminal/pkg/domain"
@@ -31,16 +32,18 @@ func LoadConfig() (*domain.Config, error) {
 	viper.SetDefault("ignored_files", []string{
 		".git", "node_modules", "dist", ".next", ".idea", ".vscode",
 	})
-	// Default launch commands (optimistic defaults for Windows Terminal with PowerShell)
-	viper.SetDefault("commands.launch_frontend", "wt.exe -w 0 new-tab -d \"{{.FrontendPath}}\" cmd /k \"{{.FrontendCmd}}\"")
-	viper.SetDefault("commands.launch_backend", "wt.exe -w 0 new-tab -d \"{{.BackendPath}}\" cmd /k \"{{.BackendCmd}}\"")
-	viper.SetDefault("commands.launch_full", "wt.exe -w 0 new-tab -d \"{{.FrontendPath}}\" cmd /k \"{{.FrontendCmd}}\" ; split-pane -d \"{{.BackendPath}}\" cmd /k \"{{.BackendCmd}}\"")
+	// Terminal backend ("auto" ortamdan tespit eder)
+	viper.SetDefault("terminal", "auto")
+	// Launch command templates are optional; when empty the terminal backend is used
+	viper.SetDefault("commands.launch_frontend", "")
+	viper.SetDefault("commands.launch_backend", "")
+	viper.SetDefault("commands.launch_full", "")
 
 	if err := viper.ReadInConfig(); err != nil {
 		if _, ok := err.(viper.ConfigFileNotFoundError); ok {
 			// Config file not found; ignore error if we want to run with defaults
 			// Or create it? For now, just return defaults.
-			fmt.Println("Warning: Config file not found, using defaults.")
+			fmt.Fprintln(os.Stderr, "Warning: Config file not found, using defaults.") // stdout komut çıktısına (sbom) karışmasın
 		} else {
 			return nil, fmt.Errorf("error reading config file: %w", err)
 		}
@@ -69,6 +72,16 @@ func LoadConfig() (*domain.Config, error) {
 		}
 	}
 
+	// Eski sürümler varsayılan olarak wt.exe şablonlarını config'e yazıyordu.
+	// Windows dışında bunlar çalışamaz, terminal backend'ine bırak.
+	if runtime.GOOS != "windows" {
+		for _, tmpl := range []*string{&cfg.Commands.LaunchFrontend, &cfg.Commands.LaunchBackend, &cfg.Commands.LaunchFull} {
+			if strings.HasPrefix(strings.TrimSpace(*tmpl), "wt.exe ") {
+				*tmpl = ""
+			}
+		}
+	}
+
 	// ---------------------------------------------------------
 	// DEDUPLICATION & NORMALIZATION LOGIC
 	// ---------------------------------------------------------
@@ -89,6 +102,33 @@ func LoadConfig() (*domain.Config, error) {
 				if override.Backend != "" {
 					existing.Backend = override.Backend
 				}
+				if override.Restart != "" {
+					existing.Restart = override.Restart
+				}
+				if override.MaxRetries != 0 {
+					existing.MaxRetries = override.MaxRetries
+				}
+				if override.Backoff != "" {
+					existing.Backoff = override.Backoff
+				}
+				if !override.FrontendReady.IsZero() {
+					existing.FrontendReady = override.FrontendReady
+				}
+				if !override.BackendReady.IsZero() {
+					existing.BackendReady = override.BackendReady
+				}
+				if len(override.SubProjects) > 0 {
+					existing.SubProjects = override.SubProjects
+				}
+				if len(override.Pipelines) > 0 {
+					existing.Pipelines = override.Pipelines
+				}
+				if !override.Watch.IsZero() {
+					existing.Watch = override.Watch
+				}
+				if override.Verify != "" {
+					existing.Verify = override.Verify
+				}
 				normalizedOverrides[normalizedPath] = existing
 			} else {
 				normalizedOverrides[normalizedPath] = override
@@ -102,20 +142,29 @@ func LoadConfig() (*domain.Config, error) {
 	return &cfg, nil
 }
 
+// ConfigDir returns the directory holding config.yaml (~/.devterminal)
+func ConfigDir() (string, error) {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".devterminal"), nil
+}
+
 // SaveConfig writes the current configuration to disk
 func SaveConfig(cfg *domain.Config) error {
 	viper.Set("projects_paths", cfg.ProjectsPaths)
 	viper.Set("project_overrides", cfg.ProjectOverrides)
 	viper.Set("last_opened", cfg.LastOpened)
+	viper.Set("embedded_launch", cfg.EmbeddedLaunch)
 	// Add other fields if necessary to sync back to viper before saving
 	// For now, we mainly accept project paths updates
 
 	// Ensure directory exists
-	home, err := os.UserHomeDir()
+	configPath, err := ConfigDir()
 	if err != nil {
 		return err
 	}
-	configPath := filepath.Join(home, ".devterminal")
 	if err := os.MkdirAll(configPath, 0755); err != nil {
 		return err
 	}
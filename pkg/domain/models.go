@@ -8,15 +8,162 @@ type Config struct {
 	Commands         Commands                   `mapstructure:"commands"`
 	IgnoredFiles     []string                   `mapstructure:"ignored_files"`
 	NgrokPath        string                     `mapstructure:"ngrok_path"`
+	Terminal         string                     `mapstructure:"terminal"`        // auto, wt, tmux, kitty, wezterm, gnome-terminal, konsole, detached
+	EmbeddedLaunch   bool                       `mapstructure:"embedded_launch"` // true ise projeler supervisor altında başlatılır
 	CustomRules      []CustomRule               `mapstructure:"custom_rules"`
 	ProjectOverrides map[string]ProjectOverride `mapstructure:"project_overrides"`
 	LastOpened       map[string]time.Time       `mapstructure:"last_opened"`
+	Pipelines        []Pipeline                 `mapstructure:"pipelines"` // Tüm projelerde kullanılabilen pipeline'lar
+	Tools            []ToolDef                  `mapstructure:"tools"`     // Ek geliştirici araçları (aynı id yerleşik aracı ezer)
+	Doctor           DoctorOptions              `mapstructure:"doctor"`
+}
+
+// DoctorOptions Dependency Doctor ayarlarıdır
+type DoctorOptions struct {
+	// Offline ise ağa çıkılmaz; kilit dosyaları manifestle karşılaştırılır (--offline ile de açılır)
+	Offline bool `mapstructure:"offline"`
+	// OSV advisory dökümünün yolu: .json dosyaları içeren klasör veya ekosistem all.zip'leri.
+	// Boşsa ~/.devterminal/osv kullanılır.
+	OSVPath string `mapstructure:"osv_path"`
+	// Doktordan yapılan yükseltmelerden sonra çalışacak doğrulama: package.json script adı veya komut.
+	// Boşsa "test" scripti (Go için "go build ./..."), "-" ise doğrulama yapılmaz.
+	Verify string `mapstructure:"verify"`
+	// Tüm projelere uygulanan bağımlılık kuralları (sağlık skorunu da etkiler)
+	Policy PolicyOptions `mapstructure:"policy"`
+}
+
+// PolicyOptions yasaklı paketler, alt sürüm sınırları ve izin verilen lisanslardır
+type PolicyOptions struct {
+	Banned   []string `mapstructure:"banned"`   // Kullanılmaması gereken paketler; glob desteklenir ("moment", "@types/*")
+	Minimum  []string `mapstructure:"minimum"`  // Alt sürüm sınırları ("next>=14", "github.com/gin-gonic/gin>=1.9")
+	Licenses []string `mapstructure:"licenses"` // İzin verilen SPDX lisansları; boşsa lisans kontrolü yapılmaz
+}
+
+// Empty hiç kural tanımlı değil mi
+func (p PolicyOptions) Empty() bool {
+	return len(p.Banned) == 0 && len(p.Minimum) == 0 && len(p.Licenses) == 0
 }
 
 // ProjectOverride proje bazlı komut özelleştirmelerini tutar
+// (yaml etiketleri SaveConfig'in alan adlarını mapstructure ile aynı yazması için)
 type ProjectOverride struct {
 	Frontend string `mapstructure:"frontend"`
 	Backend  string `mapstructure:"backend"`
+
+	// Gömülü süreçler için yeniden başlatma politikası
+	Restart    RestartPolicy `mapstructure:"restart" yaml:"restart,omitempty"`         // never, on-failure, always
+	MaxRetries int           `mapstructure:"max_retries" yaml:"max_retries,omitempty"` // 0 = varsayılan (5)
+	Backoff    string        `mapstructure:"backoff" yaml:"backoff,omitempty"`         // İlk bekleme süresi (örn: "1s"), her denemede ikiye katlanır
+
+	// Hazır olma kontrolleri ("full" modda backend hazır olunca frontend başlatılır)
+	FrontendReady ReadinessProbe `mapstructure:"frontend_ready" yaml:"frontend_ready,omitempty"`
+	BackendReady  ReadinessProbe `mapstructure:"backend_ready" yaml:"backend_ready,omitempty"`
+
+	// Monorepo'da son seçilen alt projeler ("frontend:apps/web", "backend:services/api")
+	SubProjects []string `mapstructure:"sub_projects" yaml:"sub_projects,omitempty"`
+
+	// Sadece bu projede kullanılan pipeline'lar (aynı isimli global pipeline'ı ezer)
+	Pipelines []Pipeline `mapstructure:"pipelines" yaml:"pipelines,omitempty"`
+
+	// İzleme modu: dosya değişince görevi yeniden çalıştır / süreci yeniden başlat
+	Watch WatchOptions `mapstructure:"watch" yaml:"watch,omitempty"`
+
+	// Doktordan yapılan yükseltmelerin doğrulaması (doctor.verify'ı ezer)
+	Verify string `mapstructure:"verify" yaml:"verify,omitempty"`
+}
+
+// WatchOptions izleme modunda hangi dosyaların takip edileceğini belirler.
+// Glob'lar izlenen klasöre (görevin veya sürecin çalışma dizini) göredir, "**" desteklenir.
+type WatchOptions struct {
+	Include  []string `mapstructure:"include" yaml:"include,omitempty"`   // Boşsa tüm dosyalar (örn: ["**/*.go"])
+	Exclude  []string `mapstructure:"exclude" yaml:"exclude,omitempty"`   // IgnoredFiles'a ek olarak (örn: ["**/*_test.go"])
+	Debounce string   `mapstructure:"debounce" yaml:"debounce,omitempty"` // Son değişiklikten sonra beklenecek süre (örn: "500ms")
+}
+
+// IsZero hiçbir izleme ayarı tanımlı değil mi
+func (w WatchOptions) IsZero() bool {
+	return len(w.Include) == 0 && len(w.Exclude) == 0 && w.Debounce == ""
+}
+
+// Pipeline sırayla çalışan ve ilk hatada duran isimli görev zinciridir
+type Pipeline struct {
+	Name  string         `mapstructure:"name" yaml:"name"`
+	Steps []PipelineStep `mapstructure:"steps" yaml:"steps"`
+}
+
+// PipelineStep bulunan bir görevi, ham bir komutu veya paralel çalışacak bir grubu tanımlar
+type PipelineStep struct {
+	Script   string         `mapstructure:"script" yaml:"script,omitempty"`     // Task Runner'daki görev adı (örn: "lint", "client:build")
+	Command  string         `mapstructure:"command" yaml:"command,omitempty"`   // Ham komut (örn: "go vet ./...")
+	Dir      string         `mapstructure:"dir" yaml:"dir,omitempty"`           // Ham komut için proje köküne göre dizin
+	Parallel []PipelineStep `mapstructure:"parallel" yaml:"parallel,omitempty"` // Aynı anda çalışacak adımlar
+}
+
+// ReadinessProbe bir servisin hazır olduğunu anlamak için kullanılan kontroldür.
+// Tanımlı alanlardan ilki kullanılır: TCP, HTTP, Log.
+type ReadinessProbe struct {
+	TCP     string `mapstructure:"tcp" yaml:"tcp,omitempty"`         // Açık olması beklenen port ("3000" veya "localhost:3000")
+	HTTP    string `mapstructure:"http" yaml:"http,omitempty"`       // GET isteğinin 2xx döneceği URL
+	Log     string `mapstructure:"log" yaml:"log,omitempty"`         // Log satırında aranacak regex (sadece gömülü modda)
+	Timeout string `mapstructure:"timeout" yaml:"timeout,omitempty"` // Zaman aşımı (örn: "90s"), varsayılan 60s
+}
+
+// IsZero probe tanımlı değilse true döner
+func (r ReadinessProbe) IsZero() bool {
+	return r.TCP == "" && r.HTTP == "" && r.Log == ""
+}
+
+// RestartPolicy çöken bir sürecin ne zaman yeniden başlatılacağını belirtir
+type RestartPolicy string
+
+const (
+	RestartNever     RestartPolicy = "never"
+	RestartOnFailure RestartPolicy = "on-failure"
+	RestartAlways    RestartPolicy = "always"
+)
+
+// ToolDef araç kaydındaki tek bir geliştirici aracıdır (Prisma Studio, Storybook, pgweb...).
+// Yerleşik araçlar service paketinde tanımlıdır; config'teki "tools" girdileri aynı id ile onları ezer.
+type ToolDef struct {
+	ID       string      `mapstructure:"id"`       // Benzersiz anahtar (örn: "prisma", "vitest-ui")
+	Name     string      `mapstructure:"name"`     // Menüde görünen ad
+	Icon     string      `mapstructure:"icon"`     // Menüdeki ikon (opsiyonel)
+	Key      string      `mapstructure:"key"`      // Kısayol (örn: "f6", "ctrl+p"); boşsa boştaki ilk F tuşu
+	Command  string      `mapstructure:"command"`  // Terminalde çalışacak komut (launch şablonlarıyla aynı bağlam ve fonksiyonlar)
+	Dir      ToolDirRule `mapstructure:"dir"`      // Çalışma dizini kuralı
+	Detect   ToolDetect  `mapstructure:"detect"`   // Tespit kuralları (boşsa her projede gösterilir)
+	Disabled bool        `mapstructure:"disabled"` // Yerleşik bir aracı gizlemek için
+}
+
+// ToolDetect aracın projede var sayılması için kurallardır; herhangi biri eşleşmesi yeterlidir
+type ToolDetect struct {
+	Files        []string `mapstructure:"files"`        // Dosya adı glob'ları (örn: "schema.prisma", "drizzle.config.*")
+	Dirs         []string `mapstructure:"dirs"`         // Klasör adları (örn: ".storybook", "supabase")
+	Dependencies []string `mapstructure:"dependencies"` // package.json bağımlılıkları, glob desteklenir (örn: "@storybook/*")
+}
+
+// IsZero hiçbir tespit kuralı tanımlı değil mi
+func (d ToolDetect) IsZero() bool {
+	return len(d.Files) == 0 && len(d.Dirs) == 0 && len(d.Dependencies) == 0
+}
+
+// ToolDirRule aracın hangi dizinde çalıştırılacağını belirler
+type ToolDirRule string
+
+const (
+	ToolDirMatch   ToolDirRule = "match"   // Eşleşen dosya/klasörün bulunduğu dizin (varsayılan)
+	ToolDirPackage ToolDirRule = "package" // Eşleşmenin üstündeki en yakın package.json dizini
+	ToolDirRoot    ToolDirRule = "root"    // Proje kökü
+)
+
+// ProjectTool projede tespit edilmiş, başlatılmaya hazır bir araçtır
+type ProjectTool struct {
+	ID      string
+	Name    string
+	Icon    string
+	Key     string
+	Command string
+	Dir     string // Çalışma dizini (kurala göre çözümlenmiş)
 }
 
 // CustomRule kullanıcı tanımlı tespit kuralını temsil eder
@@ -44,35 +191,27 @@ type DetectedTech struct {
 
 // SubProject monorepo içindeki bir alt projeyi temsil eder
 type SubProject struct {
-	Name       string      // Alt proje adı (klasör adı)
-	Path       string      // Alt proje yolu
-	Type       ProjectType // Teknoloji tipi
-	Version    string      // Versiyon
-	StartCmd   string      // Başlatma komutu
-	IsFrontend bool        // Frontend mi Backend mi
+	Name        string      // Alt proje adı (klasör adı)
+	PackageName string      // Paket adı (package.json / project.json "name", örn: "@acme/web")
+	Path        string      // Alt proje yolu
+	Type        ProjectType // Teknoloji tipi
+	Version     string      // Versiyon
+	StartCmd    string      // Başlatma komutu
+	IsFrontend  bool        // Frontend mi Backend mi
 }
 
 // Project diskteki bir geliştirici projesini temsil eder
 type Project struct {
-	Name         string
-	Path         string
-	Type         ProjectType
-	Tags         []string
-	HasFrontend  bool
-	HasBackend   bool
-	HasDocker    bool // Docker desteği var mı
-	HasPrisma    bool // Prisma ORM desteği var mı
-	HasDrizzle   bool // Drizzle ORM desteği var mı
-	HasHasura    bool // Hasura desteği var mı
-	HasSupabase  bool // Supabase desteği var mı
-	HasStorybook bool // Storybook desteği var mı
-
-	// Tool Paths (Sub-directory support)
-	PrismaPath    string
-	DrizzlePath   string
-	HasuraPath    string
-	SupabasePath  string
-	StorybookPath string
+	Name        string
+	Path        string
+	Type        ProjectType
+	Tags        []string
+	HasFrontend bool
+	HasBackend  bool
+	HasDocker   bool // Docker desteği var mı
+	// Tespit edilen geliştirici araçları (araç kaydına göre, bkz. ToolDef)
+	Tools        []ProjectTool
+	ToolWarnings []string // Tuşu ayrılmış veya çakışan araçlar için uyarılar
 
 	FrontendVer  string
 	BackendVer   string
@@ -95,8 +234,229 @@ type Project struct {
 	// Port uyarıları
 	PortWarnings []string // Kullanımda olan portlar
 
-	// Package Scripts
-	Scripts map[string]string // package.json scripts (key: script name, value: command)
+	// Görevler (package.json scripts, Makefile, Taskfile, justfile, composer, deno, pyproject)
+	Tasks []Task
+
+	// Proje manifesti (.devterminal.yaml) ile tanımlanan servisler
+	Services      []Service
+	ManifestError string // Manifest okunamadıysa hata mesajı
+}
+
+// Task Task Runner'da listelenen, çalıştırılabilir tek bir görevdir
+type Task struct {
+	Name    string // Listede görünen ad (alt klasördeyse "client:dev", "server:test")
+	Source  string // Kaynak: npm, make, task, just, composer, deno, python
+	Dir     string // Çalışma dizini
+	Command string // Görevin tanımı veya açıklaması
+	Run     string // Kaynağın çalıştırıcısıyla komut satırı (örn: "pnpm run dev", "make build")
+}
+
+// HistoryKind geçmiş kaydının türü
+type HistoryKind string
+
+const (
+	HistoryLaunch   HistoryKind = "launch"   // Proje, servis veya alt proje başlatma
+	HistoryTask     HistoryKind = "task"     // Task Runner görevi
+	HistoryTool     HistoryKind = "tool"     // Prisma Studio, Ngrok gibi araçlar
+	HistoryPipeline HistoryKind = "pipeline" // Görev zinciri
+)
+
+// HistoryStatus kaydın sonucu
+type HistoryStatus string
+
+const (
+	HistoryLaunched HistoryStatus = "launched" // Terminal sekmesinde açıldı, sonucu bilinmiyor
+	HistoryRunning  HistoryStatus = "running"
+	HistoryPassed   HistoryStatus = "passed"
+	HistoryFailed   HistoryStatus = "failed"
+	HistoryStopped  HistoryStatus = "stopped" // Kullanıcı tarafından durduruldu
+)
+
+// HistoryEntry ~/.devterminal/history.json'daki tek bir çalıştırma kaydıdır
+type HistoryEntry struct {
+	ID          int64         `json:"id"`
+	Time        time.Time     `json:"time"`
+	Kind        HistoryKind   `json:"kind"`
+	Project     string        `json:"project,omitempty"` // Proje yolu
+	ProjectName string        `json:"project_name,omitempty"`
+	Name        string        `json:"name"`             // Görünen ad (örn: "Full Stack", "migrate", "Prisma Studio")
+	Target      string        `json:"target,omitempty"` // Başlatma modu (full/frontend/backend), görev kaynağı veya pipeline adı
+	Command     string        `json:"command"`
+	Dir         string        `json:"dir"`
+	Env         []string      `json:"env,omitempty"`
+	Embedded    bool          `json:"embedded,omitempty"` // Terminal yerine Developer Terminal içinde mi çalıştı (supervisor / satır içi)
+	Status      HistoryStatus `json:"status"`
+	ExitCode    int           `json:"exit_code"`
+	DurationMs  int64         `json:"duration_ms"`
+
+	// Sıralı başlatma adımları aynı yolla (sıra ve hazır olma kontrolleriyle) tekrarlansın diye
+	Launch  LaunchKind `json:"launch,omitempty"`  // Başlatmanın türü
+	Targets []string   `json:"targets,omitempty"` // Seçilen alt proje anahtarları veya servis adları
+	Steps   []string   `json:"steps,omitempty"`   // Sıralı başlatmanın tüm adımları (başlatma sırasıyla)
+	// Terminalde birlikte açılan komutlar (örn: Full Stack'te frontend ve backend sekmeleri)
+	Requests []HistoryRequest `json:"requests,omitempty"`
+}
+
+// LaunchKind sıralı başlatmanın neyi başlattığı
+type LaunchKind string
+
+const (
+	LaunchProjectMode LaunchKind = "project"     // Target: frontend, backend veya full
+	LaunchSubProjects LaunchKind = "subprojects" // Targets: alt proje anahtarları
+	LaunchServices    LaunchKind = "services"    // Targets: manifest servis adları
+)
+
+// HistoryRequest geçmiş kaydında terminalde açılan tek bir komuttur
+type HistoryRequest struct {
+	Title   string   `json:"title,omitempty"`
+	Dir     string   `json:"dir"`
+	Command string   `json:"command"`
+	Env     []string `json:"env,omitempty"`
+}
+
+// Duration kaydın çalışma süresini döndürür
+func (e HistoryEntry) Duration() time.Duration {
+	return time.Duration(e.DurationMs) * time.Millisecond
+}
+
+// OutdatedDependency Dependency Doctor'ın bulduğu, güncellenebilir tek bir bağımlılıktır
+type OutdatedDependency struct {
+	Ecosystem string // Kaynak: npm, pnpm, yarn, bun, go, pip, composer, cargo
+	Name      string
+	Current   string // Kurulu / kilitli sürüm
+	Wanted    string // Manifestteki aralığın izin verdiği en yüksek sürüm
+	Latest    string
+	Dev       bool       // devDependencies, require-dev, dev grubu vb.
+	Direct    bool       // Manifestte doğrudan tanımlı mı (false: dolaylı bağımlılık)
+	Dir       string     // Manifestin bulunduğu dizin
+	Update    UpdateKind // Mevcut sürümden Latest'e geçişin semver türü
+}
+
+// UpdateKind bir yükseltmenin semver'e göre risk sınıfıdır
+type UpdateKind string
+
+const (
+	UpdateMajor      UpdateKind = "major" // Kırıcı değişiklik (1.0 öncesinde 0.x minor ve 0.0.x patch de dahil)
+	UpdateMinor      UpdateKind = "minor"
+	UpdatePatch      UpdateKind = "patch"
+	UpdatePrerelease UpdateKind = "prerelease" // Hedef sürüm bir ön sürüm (örn: 2.0.0-rc.1, Go pseudo-version)
+	UpdateUnknown    UpdateKind = "unknown"    // Sürüm semver değil veya hedef mevcut sürümden büyük değil
+)
+
+// LockfileDrift offline doktorun bulduğu manifest / kilit dosyası / kurulum uyumsuzluğudur
+type LockfileDrift struct {
+	Ecosystem string // npm, pnpm, yarn, go
+	Name      string
+	Declared  string // Manifestteki aralık (go.mod'da gereken sürüm)
+	Locked    string // Kilit dosyasındaki sürüm (yoksa boş)
+	Installed string // node_modules'taki sürüm (kurulu değilse boş)
+	Issue     DriftIssue
+	Dev       bool
+	Lockfile  string // Karşılaştırılan kilit dosyasının yolu
+	Dir       string // Manifestin bulunduğu dizin
+}
+
+// PolicyViolation bir bağımlılığın doctor.policy kurallarından birine uymamasıdır
+type PolicyViolation struct {
+	Project   string
+	Ecosystem string // npm, go, pip
+	Name      string
+	Version   string // Kilit dosyasındaki (yoksa manifestteki) sürüm
+	Rule      PolicyRule
+	Detail    string // Kural ve bulunan değer (örn: ">=14 gerekli", "GPL-3.0 izinli değil")
+	Dev       bool
+}
+
+// PolicyRule ihlal edilen kural türüdür
+type PolicyRule string
+
+const (
+	PolicyBanned  PolicyRule = "banned"  // Yasaklı paket kullanılıyor
+	PolicyMinimum PolicyRule = "minimum" // Sürüm alt sınırın altında
+	PolicyLicense PolicyRule = "license" // Lisans izin listesinde değil
+)
+
+// ImportIssue manifestteki bağımlılıklarla kaynak koddaki import'ların uyuşmazlığıdır
+type ImportIssue struct {
+	Ecosystem string // npm, go, pip
+	Name      string // Paket / modül adı
+	Issue     ImportIssueKind
+	Section   string // Manifestteki bölüm: prod, dev (Go: direct, indirect); tanımsızsa boş
+	Expected  string // Yanlış bölümdeki bağımlılığın olması gereken bölüm
+	File      string // Paketi import eden ilk dosya (manifest dizinine göre)
+	Files     int    // Paketi import eden dosya sayısı
+	Dir       string // Manifestin bulunduğu dizin
+}
+
+// ImportIssueKind import analizinin bulduğu sorun türüdür
+type ImportIssueKind string
+
+const (
+	ImportUnused    ImportIssueKind = "unused"    // Tanımlı ama hiçbir dosyada import edilmiyor
+	ImportMissing   ImportIssueKind = "missing"   // Import ediliyor ama manifestte tanımlı değil
+	ImportMisplaced ImportIssueKind = "misplaced" // Yanlış bölümde (örn: üretim kodunda kullanılan devDependency)
+)
+
+// DriftIssue manifest ile kilit dosyası arasındaki uyumsuzluk türüdür
+type DriftIssue string
+
+const (
+	DriftUnsatisfied DriftIssue = "unsatisfied" // Kilitli sürüm manifestteki aralığa uymuyor
+	DriftMissing     DriftIssue = "missing"     // Manifestteki paket kilit dosyasında yok
+	DriftInstalled   DriftIssue = "installed"   // node_modules'taki sürüm kilitli sürümden farklı
+)
+
+// Vulnerability güvenlik taramasında bulunan, kurulu / kilitli bir sürümü etkileyen tek bir advisory'dir
+type Vulnerability struct {
+	Ecosystem string // npm, pnpm, yarn, go...
+	Name      string
+	Version   string // Kilitli / kurulu sürüm (npm audit çıktısında bilinmiyorsa boş)
+	ID        string // Advisory ID (örn: GHSA-xxxx-xxxx-xxxx, CVE-2024-1234)
+	Aliases   []string
+	Title     string
+	Severity  Severity
+	Range     string // Etkilenen sürüm aralığı (örn: "<4.17.21", ">=1.0.0 <1.2.3")
+	Fixed     string // Düzeltmeyi içeren ilk sürüm (yoksa boş)
+	URL       string
+	Source    string // "npm audit", "pnpm audit", "yarn audit" veya "osv"
+	Dir       string
+}
+
+// Severity advisory önem derecesidir
+type Severity string
+
+const (
+	SeverityCritical Severity = "critical"
+	SeverityHigh     Severity = "high"
+	SeverityModerate Severity = "moderate"
+	SeverityLow      Severity = "low"
+	SeverityUnknown  Severity = "unknown"
+)
+
+// Rank sıralama için önem derecesini sayıya çevirir (critical en yüksek)
+func (s Severity) Rank() int {
+	switch s {
+	case SeverityCritical:
+		return 4
+	case SeverityHigh:
+		return 3
+	case SeverityModerate:
+		return 2
+	case SeverityLow:
+		return 1
+	}
+	return 0
+}
+
+// Service proje manifestinde tanımlı, bağımsız başlatılabilen tek bir servistir
+type Service struct {
+	Name      string            `yaml:"-"`          // Manifestteki anahtar (örn: "api")
+	Command   string            `yaml:"command"`    // Kabukta çalıştırılacak komut
+	Dir       string            `yaml:"dir"`        // Proje köküne göre çalışma dizini (varsayılan ".")
+	Env       map[string]string `yaml:"env"`        // Ek ortam değişkenleri
+	Ports     []int             `yaml:"ports"`      // Servisin dinlediği portlar
+	DependsOn []string          `yaml:"depends_on"` // Önce başlatılıp hazır olması beklenecek servisler
+	Ready     ReadinessProbe    `yaml:"ready"`      // Hazır olma kontrolü
 }
 
 // ProjectType teknoloji yığınını tanımlar
@@ -0,0 +1,526 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"devterminal/pkg/config"
+	"devterminal/pkg/domain"
+)
+
+// AuditReport güvenlik taramasının sonucudur
+type AuditReport struct {
+	Vulns   []domain.Vulnerability
+	Offline bool
+	Sources []string // Kullanılan kaynaklar (örn: "pnpm audit", "osv")
+	Errors  []string // Çalışmayan kaynaklar (örn: "npm audit: ağ hatası, OSV kullanıldı")
+	OSV     string   // Yüklenen OSV dökümünün yolu ve kayıt sayısı (yoksa boş)
+}
+
+// osvDatabase yerel OSV dökümünü bir kez yükler; başarısız yükleme önbelleğe alınmaz
+// (döküm uygulama açıkken indirilebilir)
+func (d *Doctor) osvDatabase() (*OSVDatabase, error) {
+	path := d.Config.Doctor.OSVPath
+	if path == "" {
+		dir, err := config.ConfigDir()
+		if err != nil {
+			return nil, err
+		}
+		path = filepath.Join(dir, "osv")
+	}
+	d.osvMu.Lock()
+	defer d.osvMu.Unlock()
+	if d.osv != nil && d.osv.Path == path {
+		return d.osv, nil
+	}
+	db, err := LoadOSV(path)
+	if err != nil {
+		return nil, err
+	}
+	d.osv = db
+	return db, nil
+}
+
+// Audit scans the project's dependencies for known vulnerabilities. Online, JS projects
+// use their package manager's audit command; offline (or when the audit command fails)
+// every version resolved in the lockfile is matched against the local OSV dump.
+// Go modules are always matched against OSV.
+func (d *Doctor) Audit(p *domain.Project, offline bool) (*AuditReport, error) {
+	report := &AuditReport{Offline: offline}
+	addSource := func(name string) {
+		if !containsName(report.Sources, name) {
+			report.Sources = append(report.Sources, name)
+		}
+	}
+
+	var db *OSVDatabase
+	var dbErr error
+	loadDB := func() *OSVDatabase {
+		if db == nil && dbErr == nil {
+			if db, dbErr = d.osvDatabase(); dbErr == nil {
+				report.OSV = fmt.Sprintf("%s (%d kayıt)", db.Path, db.Entries)
+			}
+		}
+		return db
+	}
+
+	checked := 0
+	done := make(map[string]bool) // Aynı workspace kökü bir kez taranır
+	auditPackageJSON := func(dir string) {
+		pm := ResolvePackageManager(dir)
+		if done[pm.Root] {
+			return
+		}
+		done[pm.Root] = true
+		checked++
+
+		if !offline {
+			vulns, err := auditJS(pm)
+			if err == nil {
+				addSource(pm.Name + " audit")
+				report.Vulns = append(report.Vulns, vulns...)
+				return
+			}
+			// Ağ yoksa OSV'ye geri düşülür
+			report.Errors = append(report.Errors, pm.Name+" audit: "+err.Error())
+		}
+		if pm.Lockfile == "" {
+			report.Errors = append(report.Errors, pm.Name+": kilit dosyası yok, OSV ile eşleştirilemedi")
+			return
+		}
+		if loadDB() == nil {
+			return
+		}
+		pkgs, err := lockedPackages(filepath.Join(pm.Root, pm.Lockfile))
+		if err != nil {
+			report.Errors = append(report.Errors, pm.Lockfile+": "+err.Error())
+			return
+		}
+		addSource("osv")
+		for _, pkg := range pkgs {
+			for _, v := range db.Match("npm", pkg.name, pkg.version) {
+				v.Ecosystem, v.Dir = pm.Name, pm.Root
+				report.Vulns = append(report.Vulns, v)
+			}
+		}
+	}
+	auditGoMod := func(dir string) {
+		checked++
+		if loadDB() == nil {
+			return
+		}
+		requires, replaced, err := readGoMod(filepath.Join(dir, "go.mod"))
+		if err != nil {
+			report.Errors = append(report.Errors, "go.mod: "+err.Error())
+			return
+		}
+		addSource("osv")
+		for _, r := range requires {
+			if replaced[r.path] {
+				continue
+			}
+			for _, v := range db.Match("Go", r.path, r.version) {
+				v.Ecosystem, v.Dir = "go", dir
+				report.Vulns = append(report.Vulns, v)
+			}
+		}
+	}
+
+	for _, dir := range projectDirs(p) {
+		if pathExists(filepath.Join(dir, "package.json")) {
+			auditPackageJSON(dir)
+		}
+		if pathExists(filepath.Join(dir, "go.mod")) {
+			auditGoMod(dir)
+		}
+	}
+
+	if checked == 0 {
+		return nil, fmt.Errorf("taranabilecek bir manifest bulunamadı (package.json, go.mod)")
+	}
+	if dbErr != nil {
+		if len(report.Sources) == 0 {
+			return nil, dbErr
+		}
+		report.Errors = append(report.Errors, "osv: "+dbErr.Error())
+	}
+	if len(report.Sources) == 0 && len(report.Errors) > 0 {
+		return nil, fmt.Errorf("%s", report.Errors[0])
+	}
+
+	report.Vulns = dedupeVulns(report.Vulns)
+	SortVulns(report.Vulns, false)
+	return report, nil
+}
+
+// SortVulns önem derecesine göre (kritik önce) veya byName ise paket adına göre sıralar
+func SortVulns(vulns []domain.Vulnerability, byName bool) {
+	sort.SliceStable(vulns, func(i, j int) bool {
+		a, b := vulns[i], vulns[j]
+		if !byName && a.Severity.Rank() != b.Severity.Rank() {
+			return a.Severity.Rank() > b.Severity.Rank()
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		if a.Severity.Rank() != b.Severity.Rank() {
+			return a.Severity.Rank() > b.Severity.Rank()
+		}
+		return a.ID < b.ID
+	})
+}
+
+// dedupeVulns aynı paket sürümü için aynı advisory'yi bir kez bırakır
+func dedupeVulns(vulns []domain.Vulnerability) []domain.Vulnerability {
+	seen := make(map[string]bool)
+	out := vulns[:0]
+	for _, v := range vulns {
+		key := v.Ecosystem + "|" + v.Name + "@" + v.Version + "|" + v.ID
+		if !seen[key] {
+			seen[key] = true
+			out = append(out, v)
+		}
+	}
+	return out
+}
+
+// auditJS paket yöneticisinin audit komutunu workspace kökünde çalıştırır
+func auditJS(pm PackageManager) ([]domain.Vulnerability, error) {
+	// Yarn 1 çıkış kodu bulunan önem derecelerinin bit maskesidir (1, 2, 4, 8, 16)
+	okCodes := []int{1}
+	if pm.Name == "yarn" && !pm.Berry() {
+		for c := 2; c < 32; c++ {
+			okCodes = append(okCodes, c)
+		}
+	}
+	out, code, err := runCheckCmdCode(pm.Root, pm.Audit(), okCodes...)
+	if err != nil {
+		return nil, err
+	}
+	out = bytes.TrimSpace(out)
+	if len(out) == 0 {
+		// Sıfır olmayan çıkış kodu ve boş çıktı: pnpm registry'ye ulaşamadığında böyle biter
+		if code != 0 {
+			return nil, fmt.Errorf("%s başarısız (exit %d): çıktı yok", strings.Join(pm.Audit(), " "), code)
+		}
+		return nil, nil
+	}
+
+	var vulns []domain.Vulnerability
+	switch pm.Name {
+	case "yarn":
+		vulns, err = parseYarnAudit(out, !pm.Berry())
+	case "bun":
+		if err := bunAuditFailure(out); err != nil {
+			return nil, err
+		}
+		vulns, err = parseBunAudit(out)
+	default:
+		if err := npmAuditFailure(out); err != nil {
+			return nil, err
+		}
+		if bytes.Contains(out, []byte(`"vulnerabilities"`)) {
+			vulns, err = parseNpmAudit(out) // npm v7+
+		} else {
+			vulns, err = parseLegacyAudit(out) // pnpm (npm v6 biçimi)
+		}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("çıktı parse edilemedi: %v", err)
+	}
+
+	source := pm.Name + " audit"
+	for i := range vulns {
+		vulns[i].Ecosystem, vulns[i].Source, vulns[i].Dir = pm.Name, source, pm.Root
+		if vulns[i].Version == "" {
+			vulns[i].Version = installedVersion(pm.Root, pm.Root, vulns[i].Name)
+		}
+		if vulns[i].Fixed == "" {
+			vulns[i].Fixed = fixedFromRange(vulns[i].Range)
+		}
+	}
+	return vulns, nil
+}
+
+var (
+	ghsaRe       = regexp.MustCompile(`GHSA(-[23456789cfghjmpqrvwx]{4}){3}`)
+	upperBoundRe = regexp.MustCompile(`^<\s*(\S+)$`)
+	lowerBoundRe = regexp.MustCompile(`^>=\s*(\S+)$`)
+)
+
+// advisoryID GitHub advisory URL'sinden GHSA kimliğini çıkarır, yoksa sayısal kaynağı kullanır
+func advisoryID(url string, fallback any) string {
+	if id := ghsaRe.FindString(url); id != "" {
+		return id
+	}
+	return fmt.Sprint(fallback)
+}
+
+// fixedFromRange "<4.17.21" gibi tek üst sınırlı aralıktan düzeltme sürümünü çıkarır
+func fixedFromRange(rng string) string {
+	if m := upperBoundRe.FindStringSubmatch(strings.TrimSpace(rng)); m != nil {
+		return m[1]
+	}
+	return ""
+}
+
+// parseNpmAudit npm v7+ "npm audit --json" çıktısını okur
+func parseNpmAudit(output []byte) ([]domain.Vulnerability, error) {
+	var res struct {
+		Vulnerabilities map[string]struct {
+			Name         string            `json:"name"`
+			Via          []json.RawMessage `json:"via"` // Advisory nesnesi veya başka bir paketin adı
+			FixAvailable json.RawMessage   `json:"fixAvailable"`
+		} `json:"vulnerabilities"`
+	}
+	if err := json.Unmarshal(output, &res); err != nil {
+		return nil, err
+	}
+	var vulns []domain.Vulnerability
+	for name, pkg := range res.Vulnerabilities {
+		var fix struct {
+			Name    string `json:"name"`
+			Version string `json:"version"`
+		}
+		_ = json.Unmarshal(pkg.FixAvailable, &fix)
+		for _, raw := range pkg.Via {
+			var via struct {
+				Source   any    `json:"source"`
+				Name     string `json:"name"`
+				Title    string `json:"title"`
+				URL      string `json:"url"`
+				Severity string `json:"severity"`
+				Range    string `json:"range"`
+			}
+			// Dolaylı kayıtlar ("via": ["lodash"]) o paketin kendi girdisinde raporlanır
+			if json.Unmarshal(raw, &via) != nil || via.Name != name {
+				continue
+			}
+			v := domain.Vulnerability{
+				Name: name, ID: advisoryID(via.URL, via.Source), Title: via.Title, URL: via.URL,
+				Severity: osvSeverity("", via.Severity), Range: via.Range,
+			}
+			if fix.Name == name {
+				v.Fixed = fix.Version
+			}
+			vulns = append(vulns, v)
+		}
+	}
+	return vulns, nil
+}
+
+// npmAuditFailure npm ve pnpm çıktısını doğrular. Registry'ye ulaşılamadığında da çıkış
+// kodu 1 olur ve {"error": …} yazılır; bu durumda hata döner ki çağıran OSV'ye geri düşebilsin
+func npmAuditFailure(output []byte) error {
+	var top map[string]json.RawMessage
+	if err := json.Unmarshal(output, &top); err != nil {
+		return fmt.Errorf("çıktı parse edilemedi: %v", err)
+	}
+	if raw, ok := top["error"]; ok {
+		return auditError(raw)
+	}
+	if top["vulnerabilities"] == nil && top["advisories"] == nil && top["metadata"] == nil {
+		return fmt.Errorf("beklenmeyen audit çıktısı (vulnerabilities/advisories/metadata yok)")
+	}
+	return nil
+}
+
+// auditError npm/pnpm'in {"error": {...}} gövdesinden okunabilir bir hata üretir
+func auditError(raw json.RawMessage) error {
+	var e struct {
+		Code    string `json:"code"`
+		Summary string `json:"summary"`
+		Message string `json:"message"`
+	}
+	if json.Unmarshal(raw, &e) != nil {
+		var msg string
+		if json.Unmarshal(raw, &msg) == nil && msg != "" {
+			return fmt.Errorf("%s", firstLine(msg))
+		}
+		return fmt.Errorf("audit hatası")
+	}
+	msg := e.Summary
+	if msg == "" {
+		msg = e.Message
+	}
+	switch {
+	case msg != "" && e.Code != "":
+		return fmt.Errorf("%s: %s", e.Code, firstLine(msg))
+	case msg != "":
+		return fmt.Errorf("%s", firstLine(msg))
+	case e.Code != "":
+		return fmt.Errorf("%s", e.Code)
+	}
+	return fmt.Errorf("audit hatası")
+}
+
+// legacyAdvisory npm v6 biçimindeki advisory'dir (pnpm audit, yarn 1 audit)
+type legacyAdvisory struct {
+	ID                 any      `json:"id"`
+	ModuleName         string   `json:"module_name"`
+	Title              string   `json:"title"`
+	URL                string   `json:"url"`
+	Severity           string   `json:"severity"`
+	VulnerableVersions string   `json:"vulnerable_versions"`
+	PatchedVersions    string   `json:"patched_versions"`
+	GithubAdvisoryID   string   `json:"github_advisory_id"`
+	CVEs               []string `json:"cves"`
+	Findings           []struct {
+		Version string `json:"version"`
+	} `json:"findings"`
+}
+
+// vulns her bulunan sürüm için bir kayıt üretir
+func (a legacyAdvisory) vulns() []domain.Vulnerability {
+	id := a.GithubAdvisoryID
+	if id == "" {
+		id = advisoryID(a.URL, a.ID)
+	}
+	fixed := ""
+	if m := lowerBoundRe.FindStringSubmatch(strings.TrimSpace(a.PatchedVersions)); m != nil {
+		fixed = m[1]
+	}
+	base := domain.Vulnerability{
+		Name: a.ModuleName, ID: id, Aliases: a.CVEs, Title: a.Title, URL: a.URL,
+		Severity: osvSeverity("", a.Severity), Range: a.VulnerableVersions, Fixed: fixed,
+	}
+	if len(a.Findings) == 0 {
+		return []domain.Vulnerability{base}
+	}
+	var out []domain.Vulnerability
+	for _, f := range a.Findings {
+		v := base
+		v.Version = f.Version
+		out = append(out, v)
+	}
+	return out
+}
+
+// parseLegacyAudit pnpm'in (npm v6 biçimi) "advisories" çıktısını okur
+func parseLegacyAudit(output []byte) ([]domain.Vulnerability, error) {
+	var res struct {
+		Advisories map[string]legacyAdvisory `json:"advisories"`
+	}
+	if err := json.Unmarshal(output, &res); err != nil {
+		return nil, err
+	}
+	var vulns []domain.Vulnerability
+	for _, a := range res.Advisories {
+		vulns = append(vulns, a.vulns()...)
+	}
+	return vulns, nil
+}
+
+// parseYarnAudit Yarn 1 ("auditAdvisory" satırları) ve Yarn 2+ ("value" / "children")
+// satır satır JSON çıktısını okur. Yarn 1 hata durumunda da bulgu bit maskesiyle aynı çıkış
+// kodlarını kullanabildiğinden "error" satırı ya da eksik "auditSummary" hata sayılır;
+// böylece çağıran OSV'ye geri düşer.
+func parseYarnAudit(output []byte, classic bool) ([]domain.Vulnerability, error) {
+	if bytes.HasPrefix(output, []byte(`{"advisories"`)) {
+		return parseLegacyAudit(output)
+	}
+	var vulns []domain.Vulnerability
+	var parsed, summary bool
+	sc := bufio.NewScanner(bytes.NewReader(output))
+	sc.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+	for sc.Scan() {
+		var line struct {
+			Type     string          `json:"type"`
+			Data     json.RawMessage `json:"data"` // auditAdvisory'de nesne, error'da metin
+			Value    string          `json:"value"`
+			Children struct {
+				ID                 any      `json:"ID"`
+				Issue              string   `json:"Issue"`
+				URL                string   `json:"URL"`
+				Severity           string   `json:"Severity"`
+				VulnerableVersions string   `json:"Vulnerable Versions"`
+				TreeVersions       []string `json:"Tree Versions"`
+			} `json:"children"`
+		}
+		if json.Unmarshal(sc.Bytes(), &line) != nil {
+			continue
+		}
+		parsed = true
+		switch {
+		case line.Type == "error":
+			var msg string
+			if json.Unmarshal(line.Data, &msg) != nil || msg == "" {
+				return nil, fmt.Errorf("audit hatası")
+			}
+			return nil, fmt.Errorf("%s", firstLine(msg))
+		case line.Type == "auditSummary":
+			summary = true
+		case line.Type == "auditAdvisory":
+			var data struct {
+				Advisory legacyAdvisory `json:"advisory"`
+			}
+			if json.Unmarshal(line.Data, &data) == nil {
+				vulns = append(vulns, data.Advisory.vulns()...)
+			}
+		case line.Value != "":
+			c := line.Children
+			base := domain.Vulnerability{
+				Name: line.Value, ID: advisoryID(c.URL, c.ID), Title: c.Issue, URL: c.URL,
+				Severity: osvSeverity("", c.Severity), Range: c.VulnerableVersions,
+			}
+			if len(c.TreeVersions) == 0 {
+				vulns = append(vulns, base)
+			}
+			for _, ver := range c.TreeVersions {
+				v := base
+				v.Version = ver
+				vulns = append(vulns, v)
+			}
+		}
+	}
+	switch {
+	case classic && !summary:
+		return nil, fmt.Errorf("beklenmeyen audit çıktısı (auditSummary yok)")
+	case !parsed:
+		return nil, fmt.Errorf("beklenmeyen audit çıktısı: %s", firstLine(string(output)))
+	}
+	return vulns, nil
+}
+
+// bunAuditFailure bun çıktısının bir JSON nesnesi olduğunu doğrular; registry hatalarında
+// bun düz metin yazar ve çağıran OSV'ye geri düşer
+func bunAuditFailure(output []byte) error {
+	var top map[string]json.RawMessage
+	if err := json.Unmarshal(output, &top); err != nil {
+		return fmt.Errorf("beklenmeyen audit çıktısı: %s", firstLine(string(output)))
+	}
+	if raw, ok := top["error"]; ok {
+		return auditError(raw)
+	}
+	return nil
+}
+
+// parseBunAudit "bun audit --json" çıktısını okur (paket adı -> advisory listesi)
+func parseBunAudit(output []byte) ([]domain.Vulnerability, error) {
+	var res map[string][]struct {
+		ID                 any    `json:"id"`
+		URL                string `json:"url"`
+		Title              string `json:"title"`
+		Severity           string `json:"severity"`
+		VulnerableVersions string `json:"vulnerable_versions"`
+	}
+	if err := json.Unmarshal(output, &res); err != nil {
+		return nil, err
+	}
+	var vulns []domain.Vulnerability
+	for name, advisories := range res {
+		for _, a := range advisories {
+			vulns = append(vulns, domain.Vulnerability{
+				Name: name, ID: advisoryID(a.URL, a.ID), Title: a.Title, URL: a.URL,
+				Severity: osvSeverity("", a.Severity), Range: a.VulnerableVersions,
+			})
+		}
+	}
+	return vulns, nil
+}
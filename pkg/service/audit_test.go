@@ -0,0 +1,55 @@
+package service
+
+import "testing"
+
+func TestParseYarnAuditFailure(t *testing.T) {
+	advisory := `{"type":"auditAdvisory","data":{"advisory":{"id":1,"module_name":"lodash","severity":"high","title":"Prototype Pollution","url":"https://github.com/advisories/GHSA-jf85-cpcp-j695","vulnerable_versions":"<4.17.21","findings":[{"version":"4.17.20"}]}}}`
+	summary := `{"type":"auditSummary","data":{"vulnerabilities":{"high":1}}}`
+	tests := []struct {
+		name    string
+		output  string
+		classic bool
+		vulns   int
+		wantErr bool
+	}{
+		{"yarn 1 bulgu ve özet", advisory + "\n" + summary, true, 1, false},
+		{"yarn 1 sadece özet", summary, true, 0, false},
+		{"yarn 1 özet yok", advisory, true, 0, true},
+		{"yarn 1 hata satırı", `{"type":"error","data":"Error: Request failed \"500 Internal Server Error\""}` + "\n" + summary, true, 0, true},
+		{"yarn 1 düz metin", "error An unexpected error occurred", true, 0, true},
+		{"yarn 2+ bulgu", `{"value":"lodash","children":{"ID":1,"Issue":"Prototype Pollution","URL":"https://github.com/advisories/GHSA-jf85-cpcp-j695","Severity":"high","Vulnerable Versions":"<4.17.21","Tree Versions":["4.17.20"]}}`, false, 1, false},
+		{"yarn 2+ hata satırı", `{"type":"error","data":"YN0035: request failed"}`, false, 0, true},
+		{"yarn 2+ düz metin", "➤ YN0035: The remote server failed to provide the requested resource", false, 0, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			vulns, err := parseYarnAudit([]byte(tt.output), tt.classic)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseYarnAudit hata = %v, hata bekleniyor: %v", err, tt.wantErr)
+			}
+			if len(vulns) != tt.vulns {
+				t.Errorf("parseYarnAudit %d bulgu döndürdü, beklenen %d", len(vulns), tt.vulns)
+			}
+		})
+	}
+}
+
+func TestBunAuditFailure(t *testing.T) {
+	tests := []struct {
+		name    string
+		output  string
+		wantErr bool
+	}{
+		{"bulgu", `{"lodash":[{"id":1,"title":"Prototype Pollution","severity":"high","vulnerable_versions":"<4.17.21"}]}`, false},
+		{"bulgu yok", `{}`, false},
+		{"düz metin", "error: audit request failed (500)", true},
+		{"error nesnesi", `{"error":{"code":"ENOTFOUND","summary":"registry unreachable"}}`, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := bunAuditFailure([]byte(tt.output)); (err != nil) != tt.wantErr {
+				t.Errorf("bunAuditFailure(%q) = %v, hata bekleniyor: %v", tt.output, err, tt.wantErr)
+			}
+		})
+	}
+}
@@ -1,76 +1,188 @@
 package service
 
 import (
-	"encoding/json"
 	"fmt"
-	"os"
-	"os/exec"
 	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
 
 	"devterminal/pkg/domain"
 )
 
 type Doctor struct {
 	Config *domain.Config
+
+	osvMu sync.Mutex
+	osv   *OSVDatabase // Yüklenen OSV dökümü (güvenlik taraması)
 }
 
 func NewDoctor(cfg *domain.Config) *Doctor {
 	return &Doctor{Config: cfg}
 }
 
-type NpmOutdatedResult map[string]struct {
-	Current string `json:"current"`
-	Wanted  string `json:"wanted"`
-	Latest  string `json:"latest"`
+// DoctorReport tüm ekosistemlerden toplanan eski bağımlılıklardır
+type DoctorReport struct {
+	Deps    []domain.OutdatedDependency
+	Drift   []domain.LockfileDrift // Offline modda kilit dosyası uyumsuzlukları
+	Offline bool
+	Sources []string // Kontrol edilen kaynaklar (örn: "pnpm", "go")
+	Errors  []string // Çalışmayan kaynaklar (örn: "pip: pip bulunamadı")
 }
 
-// CheckDependencies runs npm outdated in the project path with comprehensive validation
-func (d *Doctor) CheckDependencies(path string) (NpmOutdatedResult, error) {
-	// 1. Check if npm is installed
-	npmCheck := exec.Command("npm", "--version")
-	if err := npmCheck.Run(); err != nil {
-		return nil, fmt.Errorf("npm bulunamadı. Lütfen Node.js ve npm'in kurulu olduğundan emin olun")
+// maxOutdatedJobs aynı anda çalışan outdated komutu sayısı
+const maxOutdatedJobs = 4
+
+// CheckDependencies runs every matching ecosystem's outdated command in the project's
+// root, frontend, backend and sub-project directories (in parallel) and merges the results
+func (d *Doctor) CheckDependencies(p *domain.Project) (*DoctorReport, error) {
+	type job struct {
+		src OutdatedSource
+		dir string
+	}
+	var jobs []job
+	for _, dir := range projectDirs(p) {
+		for _, src := range OutdatedSources() {
+			if src.Detect(dir) {
+				jobs = append(jobs, job{src, dir})
+			}
+		}
+	}
+	if len(jobs) == 0 {
+		return nil, fmt.Errorf("desteklenen bir manifest bulunamadı (package.json, go.mod, requirements.txt, pyproject.toml, composer.json, Cargo.toml)")
 	}
 
-	// 2. Check if package.json exists
-	packageJSONPath := filepath.Join(path, "package.json")
-	if _, err := os.Stat(packageJSONPath); os.IsNotExist(err) {
-		return nil, fmt.Errorf("package.json bulunamadı. Bu proje bir Node.js projesi değil")
+	type result struct {
+		deps []domain.OutdatedDependency
+		err  error
+	}
+	results := make([]result, len(jobs))
+	var wg sync.WaitGroup
+	// Monorepo'da her alt proje ayrı bir komut çalıştırır; aynı anda çalışanlar sınırlanır
+	sem := make(chan struct{}, maxOutdatedJobs)
+	for i, j := range jobs {
+		wg.Add(1)
+		go func(i int, j job) {
+			defer wg.Done()
+			sem <- struct{}{}
+			defer func() { <-sem }()
+			deps, err := j.src.Outdated(j.dir)
+			results[i] = result{deps, err}
+		}(i, j)
 	}
+	wg.Wait()
 
-	// 3. Run npm outdated
-	cmd := exec.Command("npm", "outdated", "--json")
-	cmd.Dir = path
+	report := &DoctorReport{}
+	seen := make(map[string]bool)
+	for i, r := range results {
+		name := jobs[i].src.Name()
+		if name == "npm" {
+			name = ResolvePackageManager(jobs[i].dir).Name
+		}
+		if !seen[name] {
+			seen[name] = true
+			report.Sources = append(report.Sources, name)
+		}
+		if r.err != nil {
+			report.Errors = append(report.Errors, name+": "+r.err.Error())
+			continue
+		}
+		report.Deps = append(report.Deps, r.deps...)
+	}
+	if len(report.Errors) == len(jobs) {
+		return nil, fmt.Errorf("%s", report.Errors[0])
+	}
+	for i := range report.Deps {
+		report.Deps[i].Update = ClassifyUpdate(report.Deps[i].Current, report.Deps[i].Latest)
+	}
 
-	// Use CombinedOutput to capture both stdout and stderr
-	output, err := cmd.CombinedOutput()
+	// Ekosistem, sonra paket adına göre sırala
+	order := make(map[string]int)
+	for i, s := range report.Sources {
+		order[s] = i
+	}
+	sort.SliceStable(report.Deps, func(i, j int) bool {
+		a, b := report.Deps[i], report.Deps[j]
+		if a.Ecosystem != b.Ecosystem {
+			return order[a.Ecosystem] < order[b.Ecosystem]
+		}
+		return a.Name < b.Name
+	})
+	return report, nil
+}
 
-	// npm outdated returns exit code 1 if there are outdated packages
-	// This is NOT an error for us - we want to parse the output
-	if err != nil {
-		if exitErr, ok := err.(*exec.ExitError); ok {
-			// Exit code 1 means outdated packages exist - this is what we want!
-			if exitErr.ExitCode() == 1 {
-				// Continue to parse output below
-			} else {
-				// Other exit codes indicate real errors
-				return nil, fmt.Errorf("npm outdated komutu başarısız (exit %d): %s", exitErr.ExitCode(), string(output))
+// CheckLockfiles is the offline counterpart of CheckDependencies: it never touches the
+// network and compares each lockfile (package-lock.json, pnpm-lock.yaml, yarn.lock, go.sum)
+// with the manifest's declared ranges and the installed node_modules
+func (d *Doctor) CheckLockfiles(p *domain.Project) (*DoctorReport, error) {
+	report := &DoctorReport{Offline: true}
+	seen := make(map[string]bool)
+	checked := 0
+	for _, dir := range projectDirs(p) {
+		for _, src := range DriftSources() {
+			if !src.Detect(dir) {
+				continue
+			}
+			checked++
+			name := src.Name()
+			if name == "npm" {
+				name = ResolvePackageManager(dir).Name
+			}
+			if !seen[name] {
+				seen[name] = true
+				report.Sources = append(report.Sources, name)
 			}
-		} else {
-			return nil, fmt.Errorf("npm komutu çalıştırılamadı: %v", err)
+			drift, err := src.Drift(dir)
+			if err != nil {
+				report.Errors = append(report.Errors, name+": "+err.Error())
+				continue
+			}
+			report.Drift = append(report.Drift, drift...)
 		}
 	}
+	if checked == 0 {
+		return nil, fmt.Errorf("kilit dosyasıyla karşılaştırılabilecek bir manifest bulunamadı (package.json, go.mod)")
+	}
+	if len(report.Errors) == checked {
+		return nil, fmt.Errorf("%s", report.Errors[0])
+	}
 
-	// Empty output means all packages are up to date (exit code was 0)
-	if len(output) == 0 {
-		return nil, nil
+	order := make(map[string]int)
+	for i, s := range report.Sources {
+		order[s] = i
 	}
+	sort.SliceStable(report.Drift, func(i, j int) bool {
+		a, b := report.Drift[i], report.Drift[j]
+		if a.Ecosystem != b.Ecosystem {
+			return order[a.Ecosystem] < order[b.Ecosystem]
+		}
+		return a.Name < b.Name
+	})
+	return report, nil
+}
 
-	// Parse JSON result
-	var res NpmOutdatedResult
-	if err := json.Unmarshal(output, &res); err != nil {
-		return nil, fmt.Errorf("npm çıktısı parse edilemedi: %v\nOutput: %s", err, string(output))
+// projectDirs projenin kök, frontend, backend ve monorepo alt proje dizinlerini tekrarsız döndürür
+func projectDirs(p *domain.Project) []string {
+	candidates := []string{p.Path, p.FrontendPath, p.BackendPath}
+	for _, sp := range p.AllFrontends {
+		candidates = append(candidates, sp.Path)
+	}
+	for _, sp := range p.AllBackends {
+		candidates = append(candidates, sp.Path)
 	}
 
-	return res, nil
+	var dirs []string
+	seen := make(map[string]bool)
+	for _, dir := range candidates {
+		if dir == "" {
+			continue
+		}
+		key := strings.ToLower(filepath.Clean(dir))
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		dirs = append(dirs, dir)
+	}
+	return dirs
 }
@@ -0,0 +1,23 @@
+package service
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"devterminal/pkg/domain"
+)
+
+func TestProjectDirs(t *testing.T) {
+	root := "repo"
+	web, api := filepath.Join(root, "apps", "web"), filepath.Join(root, "services", "api")
+	p := &domain.Project{
+		Path: root, FrontendPath: web, BackendPath: api,
+		AllFrontends: []domain.SubProject{{Path: web}, {Path: filepath.Join(root, "apps", "admin")}},
+		AllBackends:  []domain.SubProject{{Path: api + string(filepath.Separator)}, {Path: filepath.Join(root, "services", "worker")}, {Path: ""}},
+	}
+	want := []string{root, web, api, filepath.Join(root, "apps", "admin"), filepath.Join(root, "services", "worker")}
+	if got := projectDirs(p); strings.Join(got, "|") != strings.Join(want, "|") {
+		t.Errorf("projectDirs = %q, beklenen %q", got, want)
+	}
+}
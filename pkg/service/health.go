@@ -1,9 +1,12 @@
 package service
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
+
+	"devterminal/pkg/domain"
 )
 
 type HealthIssue struct {
@@ -16,15 +19,25 @@ type HealthReport struct {
 	MaxScore    int
 	Issues      []HealthIssue
 	PassedItems []string
+	Notes       []string // Skora dahil edilmeyen kontroller hakkında bilgi
 }
 
-type HealthService struct{}
+type HealthService struct {
+	Config *domain.Config
+}
 
-func NewHealthService() *HealthService {
-	return &HealthService{}
+func NewHealthService(cfg *domain.Config) *HealthService {
+	return &HealthService{Config: cfg}
 }
 
-func (s *HealthService) CheckHealth(projectPath string) HealthReport {
+// policyPenalty her politika ihlalinin puanı ve toplamda düşülebilecek en fazla puan
+const (
+	policyPenalty    = 5
+	maxPolicyPenalty = 25
+)
+
+func (s *HealthService) CheckHealth(p *domain.Project) HealthReport {
+	projectPath := p.Path
 	score := 0
 	maxScore := 100
 	var issues []HealthIssue
@@ -191,10 +204,40 @@ func (s *HealthService) CheckHealth(projectPath string) HealthReport {
 		issues = append(issues, HealthIssue{"Lisans dosyası eksik", 10})
 	}
 
+	// Bağımlılık politikası (doctor.policy): yasaklı paket ve minimum sürüm ihlali başına puan düşülür.
+	// Lisans izin listesi node_modules'ı okuduğu için her taramada değil, sadece politika ekranında uygulanır
+	if policy := s.Config.Doctor.Policy; len(policy.Banned) > 0 || len(policy.Minimum) > 0 {
+		report := NewDoctor(s.Config).CheckDependencyRules(p)
+		if n := len(report.Violations); n > 0 {
+			points := n * policyPenalty
+			if points > maxPolicyPenalty {
+				points = maxPolicyPenalty
+			}
+			var names []string
+			for i, v := range report.Violations {
+				if i == 3 {
+					names = append(names, "...")
+					break
+				}
+				names = append(names, v.Name+" "+v.Detail)
+			}
+			issues = append(issues, HealthIssue{fmt.Sprintf("%d bağımlılık politikası ihlali: %s", n, strings.Join(names, ", ")), points})
+			score -= points
+			if score < 0 {
+				score = 0
+			}
+		}
+	}
+	var notes []string
+	if len(s.Config.Doctor.Policy.Licenses) > 0 {
+		notes = append(notes, "Lisans izin listesi skora dahil değildir; lisans ihlalleri proje listesinde [p] Politika ekranında görülür")
+	}
+
 	return HealthReport{
 		Score:       score,
 		MaxScore:    maxScore,
 		Issues:      issues,
 		PassedItems: passed,
+		Notes:       notes,
 	}
 }
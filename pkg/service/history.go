@@ -0,0 +1,235 @@
+package service
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"devterminal/pkg/domain"
+)
+
+// historyFileName çalıştırma geçmişinin ~/.devterminal altındaki dosya adı
+const historyFileName = "history.json"
+
+// maxHistoryEntries dosyada tutulacak en fazla kayıt (en eskiler silinir)
+const maxHistoryEntries = 1000
+
+// History başlatma, görev ve araç çalıştırmalarını diske kaydeder
+type History struct {
+	path string
+
+	mu      sync.Mutex
+	entries []domain.HistoryEntry // Eskiden yeniye
+	loaded  bool
+	lastID  int64
+}
+
+// NewHistory ~/.devterminal/history.json dosyasını kullanan geçmiş deposu oluşturur.
+// Ev dizini bulunamazsa kayıtlar sadece bellekte tutulur.
+func NewHistory() *History {
+	h := &History{}
+	if home, err := os.UserHomeDir(); err == nil {
+		h.path = filepath.Join(home, ".devterminal", historyFileName)
+	}
+	return h
+}
+
+// load dosyayı ilk kullanımda okur (mu kilitliyken çağrılır)
+func (h *History) load() {
+	if h.loaded {
+		return
+	}
+	h.loaded = true
+	if h.path == "" {
+		return
+	}
+	data, err := os.ReadFile(h.path)
+	if err != nil {
+		return
+	}
+	_ = json.Unmarshal(data, &h.entries)
+	for _, e := range h.entries {
+		if e.ID > h.lastID {
+			h.lastID = e.ID
+		}
+	}
+}
+
+// save kayıtları diske yazar (mu kilitliyken çağrılır)
+func (h *History) save() {
+	if h.path == "" {
+		return
+	}
+	if len(h.entries) > maxHistoryEntries {
+		h.entries = h.entries[len(h.entries)-maxHistoryEntries:]
+	}
+	data, err := json.MarshalIndent(h.entries, "", "  ")
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(h.path), 0755); err != nil {
+		return
+	}
+	// Yarım kalmış yazma dosyayı bozmasın
+	tmp := h.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err == nil {
+		_ = os.Rename(tmp, h.path)
+	}
+}
+
+// Entries kayıtları en yeniden eskiye döndürür
+func (h *History) Entries() []domain.HistoryEntry {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.load()
+
+	out := make([]domain.HistoryEntry, len(h.entries))
+	for i, e := range h.entries {
+		out[len(out)-1-i] = e
+	}
+	return out
+}
+
+// Record yeni bir kayıt ekler ve kimliğini döndürür
+func (h *History) Record(e domain.HistoryEntry) int64 {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.load()
+
+	if e.Time.IsZero() {
+		e.Time = time.Now()
+	}
+	// Kimlik zaman damgasıdır; aynı anda gelen kayıtlar için bir artırılır
+	e.ID = e.Time.UnixNano()
+	if e.ID <= h.lastID {
+		e.ID = h.lastID + 1
+	}
+	h.lastID = e.ID
+
+	h.entries = append(h.entries, e)
+	h.save()
+	return e.ID
+}
+
+// Finish çalışan bir kaydın sonucunu ve süresini yazar
+func (h *History) Finish(id int64, status domain.HistoryStatus, exitCode int, d time.Duration) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.load()
+
+	for i := len(h.entries) - 1; i >= 0; i-- {
+		if h.entries[i].ID == id {
+			h.entries[i].Status = status
+			h.entries[i].ExitCode = exitCode
+			h.entries[i].DurationMs = d.Milliseconds()
+			h.save()
+			return
+		}
+	}
+}
+
+// Track süreci "running" olarak kaydeder ve ilk çalışması bitince sonucunu yazar
+func (h *History) Track(e domain.HistoryEntry, mp *ManagedProcess, startErr error) {
+	e.Command = mp.Spec.Command
+	e.Dir = mp.Spec.Dir
+	e.Env = mp.Spec.Env
+	e.Embedded = true
+	if startErr != nil {
+		e.Status = domain.HistoryFailed
+		e.ExitCode = -1
+		h.Record(e)
+		return
+	}
+
+	e.Status = domain.HistoryRunning
+	id := h.Record(e)
+	done := mp.Done()
+	go func() {
+		<-done
+		status, code := historyResult(mp.Info())
+		h.Finish(id, status, code, mp.Info().Uptime())
+	}()
+}
+
+// historyResult süreç durumunu geçmiş sonucuna çevirir
+func historyResult(info ProcessInfo) (domain.HistoryStatus, int) {
+	switch info.Status {
+	case ProcessExited:
+		return domain.HistoryPassed, 0
+	case ProcessStopped:
+		return domain.HistoryStopped, info.ExitCode
+	default:
+		return domain.HistoryFailed, info.ExitCode
+	}
+}
+
+// recordLaunched terminal sekmesinde açılan (sonucu izlenemeyen) bir çalıştırmayı kaydeder
+func (l *Launcher) recordLaunched(e domain.HistoryEntry, p *domain.Project, reqs []TerminalRequest, err error) {
+	if p != nil {
+		e.Project, e.ProjectName = p.Path, p.Name
+	}
+	cmds := make([]string, 0, len(reqs))
+	for _, r := range reqs {
+		cmds = append(cmds, r.Command)
+	}
+	if e.Command == "" {
+		// Sadece gösterim için; birden fazla sekme Requests'ten ayrı ayrı tekrar açılır
+		e.Command = strings.Join(cmds, " · ")
+	}
+	if e.Dir == "" && len(reqs) == 1 {
+		e.Dir = reqs[0].Dir
+		e.Env = reqs[0].Env
+	} else if e.Dir == "" && p != nil {
+		e.Dir = p.Path
+	}
+	if len(reqs) > 1 {
+		e.Requests = make([]domain.HistoryRequest, len(reqs))
+		for i, r := range reqs {
+			e.Requests[i] = domain.HistoryRequest{Title: r.Title, Dir: r.Dir, Command: r.Command, Env: r.Env}
+		}
+	}
+	e.Status = domain.HistoryLaunched
+	if err != nil {
+		e.Status = domain.HistoryFailed
+		e.ExitCode = -1
+	}
+	l.History.Record(e)
+}
+
+// trackProcess supervisor altında başlatılan bir süreci geçmişe ekler
+func (l *Launcher) trackProcess(e domain.HistoryEntry, p *domain.Project, mp *ManagedProcess, err error) {
+	if mp == nil {
+		return
+	}
+	e.Project, e.ProjectName = p.Path, p.Name
+	l.History.Track(e, mp, err)
+}
+
+// Rerun geçmişteki bir başlatma veya araç kaydını aynı komut ve dizinle tekrar çalıştırır.
+// Görev ve pipeline kayıtları UI'da satır içi çalıştırılır.
+func (l *Launcher) Rerun(e domain.HistoryEntry) error {
+	p := &domain.Project{Path: e.Project, Name: e.ProjectName}
+	reqs := []TerminalRequest{{Title: e.Name, Dir: e.Dir, Command: e.Command, Env: e.Env}}
+	if len(e.Requests) > 0 {
+		reqs = make([]TerminalRequest, len(e.Requests))
+		for i, r := range e.Requests {
+			reqs[i] = TerminalRequest{Title: r.Title, Dir: r.Dir, Command: r.Command, Env: r.Env}
+		}
+	}
+	e.ID, e.Time, e.DurationMs, e.ExitCode = 0, time.Time{}, 0, 0
+
+	if e.Embedded && e.Kind != domain.HistoryTool {
+		mp, err := l.Supervisor.Start(ProcessSpec{Name: e.Name, ProjectPath: e.Project, Dir: e.Dir, Command: e.Command, Env: e.Env})
+		l.trackProcess(e, p, mp, err)
+		return err
+	}
+	err := openInTerminal(l.Terminal, reqs...)
+	if e.Project == "" {
+		p = nil
+	}
+	l.recordLaunched(e, p, reqs, err)
+	return err
+}
@@ -0,0 +1,758 @@
+package service
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"devterminal/pkg/domain"
+)
+
+// ImportReport manifestlerle kaynak koddaki import'ların karşılaştırma sonucudur
+type ImportReport struct {
+	Issues  []domain.ImportIssue
+	Sources []string // Kontrol edilen ekosistemler (örn: "npm", "go")
+	Errors  []string // Okunamayan manifestler (örn: "go: go.mod okunamadı")
+	Files   int      // Taranan kaynak dosya sayısı
+}
+
+// ImportSource bir ekosistemin kaynak dosyalarındaki import'ları manifestle karşılaştırır (offline)
+type ImportSource interface {
+	// Name tabloda gösterilen ekosistem adı
+	Name() string
+	// Detect klasörde bu ekosistemin manifesti var mı
+	Detect(dir string) bool
+	// Check kullanılmayan, tanımsız ve yanlış bölümdeki bağımlılıkları ve taranan dosya sayısını döndürür
+	Check(dir string, ignored map[string]bool) ([]domain.ImportIssue, int, error)
+}
+
+// ImportSources desteklenen kaynakları tabloda görünecekleri sırayla döndürür
+func ImportSources() []ImportSource {
+	return []ImportSource{
+		jsImports{},
+		goImports{},
+		pyImports{},
+	}
+}
+
+// CheckImports scans the source files under the project's root, frontend, backend and sub-project
+// directories and cross-checks their imports with the declared dependencies. It never
+// touches the network; folders in the config's ignored_files list are skipped.
+func (d *Doctor) CheckImports(p *domain.Project) (*ImportReport, error) {
+	ignored := make(map[string]bool)
+	for _, name := range d.Config.IgnoredFiles {
+		ignored[name] = true
+	}
+
+	report := &ImportReport{}
+	checked := 0
+	for _, dir := range projectDirs(p) {
+		for _, src := range ImportSources() {
+			if !src.Detect(dir) {
+				continue
+			}
+			checked++
+			if !containsName(report.Sources, src.Name()) {
+				report.Sources = append(report.Sources, src.Name())
+			}
+			issues, files, err := src.Check(dir, ignored)
+			if err != nil {
+				report.Errors = append(report.Errors, src.Name()+": "+err.Error())
+				continue
+			}
+			report.Files += files
+			report.Issues = append(report.Issues, issues...)
+		}
+	}
+	if checked == 0 {
+		return nil, fmt.Errorf("import'ları karşılaştırılabilecek bir manifest bulunamadı (package.json, go.mod, requirements.txt, pyproject.toml)")
+	}
+	if len(report.Errors) == checked {
+		return nil, fmt.Errorf("%s", report.Errors[0])
+	}
+
+	order := make(map[string]int)
+	for i, s := range report.Sources {
+		order[s] = i
+	}
+	kinds := map[domain.ImportIssueKind]int{domain.ImportMissing: 0, domain.ImportMisplaced: 1, domain.ImportUnused: 2}
+	sort.SliceStable(report.Issues, func(i, j int) bool {
+		a, b := report.Issues[i], report.Issues[j]
+		if a.Ecosystem != b.Ecosystem {
+			return order[a.Ecosystem] < order[b.Ecosystem]
+		}
+		if a.Issue != b.Issue {
+			return kinds[a.Issue] < kinds[b.Issue]
+		}
+		return a.Name < b.Name
+	})
+	return report, nil
+}
+
+// skippedSourceDirs kaynak taramasında hiç girilmeyen klasörler (bağımlılıklar, derleme çıktıları)
+var skippedSourceDirs = map[string]bool{
+	"node_modules": true, "vendor": true, "dist": true, "build": true, "out": true, "coverage": true,
+	"testdata": true, "__pycache__": true, "venv": true, "env": true, "site-packages": true, "target": true,
+}
+
+// maxSourceSize bu boyuttan büyük dosyalar (derlenmiş / minify edilmiş) okunmaz
+const maxSourceSize = 1 << 20
+
+// walkSources dir altındaki kaynak dosyaları okur. Gizli ve yok sayılan klasörlere,
+// kendi manifesti olan alt klasörlere (ayrı paket / modül) girilmez.
+func walkSources(dir string, ignored map[string]bool, manifests []string, match func(name string) bool, visit func(rel string, data []byte)) (int, error) {
+	files := 0
+	err := filepath.WalkDir(dir, func(path string, e fs.DirEntry, err error) error {
+		if err != nil {
+			// Okunamayan klasörler atlanır
+			if path == dir {
+				return err
+			}
+			return nil
+		}
+		name := e.Name()
+		if e.IsDir() {
+			if path == dir {
+				return nil
+			}
+			if skippedSourceDirs[name] || ignored[name] || strings.HasPrefix(name, ".") {
+				return filepath.SkipDir
+			}
+			for _, m := range manifests {
+				if pathExists(filepath.Join(path, m)) {
+					return filepath.SkipDir
+				}
+			}
+			return nil
+		}
+		if ignored[name] || !match(name) {
+			return nil
+		}
+		if info, err := e.Info(); err != nil || info.Size() > maxSourceSize {
+			return nil
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil
+		}
+		rel, err := filepath.Rel(dir, path)
+		if err != nil {
+			rel = name
+		}
+		files++
+		visit(filepath.ToSlash(rel), data)
+		return nil
+	})
+	return files, err
+}
+
+// importUsage bir paketin kaynak kodda nerede kullanıldığıdır
+type importUsage struct {
+	files int
+	first string // Paketi import eden ilk dosya (varsa üretim kodundaki)
+	prod  bool   // Test / yapılandırma dışındaki bir dosyada kullanılıyor mu
+}
+
+type usageMap map[string]*importUsage
+
+// add dosyadaki paketleri kaydeder; aynı dosyadaki tekrarlar bir kez sayılır
+func (u usageMap) add(file string, dev bool, names map[string]bool) {
+	for name := range names {
+		use, ok := u[name]
+		if !ok {
+			use = &importUsage{first: file}
+			u[name] = use
+		}
+		use.files++
+		if !dev && !use.prod {
+			use.prod, use.first = true, file
+		}
+	}
+}
+
+// sectionName bağımlılık bölümünün tablodaki adı
+func sectionName(dev bool) string {
+	if dev {
+		return "dev"
+	}
+	return "prod"
+}
+
+// compareImports tanımlı bağımlılıkları (değer: dev mi) kullanımla karşılaştırır.
+// keep true dönen paketler hiç import edilmese de kullanılmıyor sayılmaz (CLI araçları, tip paketleri).
+func compareImports(eco, dir string, declared map[string]bool, used usageMap, keep func(name string) bool) []domain.ImportIssue {
+	var issues []domain.ImportIssue
+	for name, use := range used {
+		issue := domain.ImportIssue{Ecosystem: eco, Name: name, File: use.first, Files: use.files, Dir: dir}
+		dev, ok := declared[name]
+		switch {
+		case !ok:
+			issue.Issue, issue.Expected = domain.ImportMissing, sectionName(!use.prod)
+		case dev && use.prod:
+			// Üretim kodunda kullanılan dev bağımlılığı kurulumda eksik kalır
+			issue.Issue, issue.Section, issue.Expected = domain.ImportMisplaced, "dev", "prod"
+		case !dev && !use.prod:
+			// Sadece testlerde kullanılan paket üretim paketine gereksiz yere girer
+			issue.Issue, issue.Section, issue.Expected = domain.ImportMisplaced, "prod", "dev"
+		default:
+			continue
+		}
+		issues = append(issues, issue)
+	}
+	for name, dev := range declared {
+		if _, ok := used[name]; ok || keep(name) {
+			continue
+		}
+		issues = append(issues, domain.ImportIssue{Ecosystem: eco, Name: name, Issue: domain.ImportUnused, Section: sectionName(dev), Dir: dir})
+	}
+	return issues
+}
+
+// referencedIn paket adı (veya çalıştırılabilir dosyası) metinde geçiyor mu
+func referencedIn(text string, names ...string) bool {
+	for _, n := range names {
+		if n != "" && strings.Contains(text, n) {
+			return true
+		}
+	}
+	return false
+}
+
+// --- package.json (JS / TS) ---
+
+type jsImports struct{}
+
+func (jsImports) Name() string { return "npm" }
+
+func (jsImports) Detect(dir string) bool {
+	return pathExists(filepath.Join(dir, "package.json"))
+}
+
+var (
+	// import x from "a", import "a", import type { X } from "a", export * from "a"
+	jsStaticImportRe = regexp.MustCompile(`(?m)(?:^|[^.\w$])(?:import|export)\s+(?:type\s+)?(?:[\w*{}\s,$]+\s+from\s+)?['"]([^'"\n]+)['"]`)
+	// require("a"), import("a")
+	jsCallImportRe = regexp.MustCompile(`(?:^|[^.\w$])(?:require|import)\s*\(\s*['"]([^'"\n]+)['"]\s*\)`)
+	// Testler, hikayeler ve araç yapılandırmaları dev bağımlılıklarını kullanabilir
+	jsDevFileRe = regexp.MustCompile(`(^|/)(__tests__|__mocks__|tests?|e2e|cypress|playwright|stories)/|\.(test|spec|stories|story|cy|bench)\.[^/]+$|(^|/)[^/]*\.config\.[^/]+$|(^|/)[^/]*rc\.[cm]?js$|\.d\.ts$`)
+	jsPathsRe   = regexp.MustCompile(`"paths"\s*:\s*\{([^}]*)\}`)
+	jsPathKeyRe = regexp.MustCompile(`"([^"]+)"\s*:`)
+)
+
+var jsSourceExts = map[string]bool{
+	".js": true, ".jsx": true, ".ts": true, ".tsx": true, ".mjs": true, ".cjs": true, ".mts": true, ".cts": true,
+	".vue": true, ".svelte": true, ".astro": true,
+}
+
+// nodeBuiltins "node:" öneki olmadan da import edilebilen Node.js modülleri
+var nodeBuiltins = toSet(`assert async_hooks buffer child_process cluster console constants crypto dgram
+	diagnostics_channel dns domain events fs http http2 https inspector module net os path perf_hooks process
+	punycode querystring readline repl stream string_decoder sys timers tls trace_events tty url util v8 vm
+	wasi worker_threads zlib`)
+
+// jsBinAliases çalıştırılabilir dosyasının adı paket adından farklı olan yaygın araçlar
+var jsBinAliases = map[string][]string{
+	"typescript":       {"tsc"},
+	"@biomejs/biome":   {"biome"},
+	"@playwright/test": {"playwright"},
+	"@angular/cli":     {"ng "},
+	"@vue/cli-service": {"vue-cli-service"},
+	"npm-run-all":      {"run-p", "run-s"},
+	"npm-run-all2":     {"run-p", "run-s"},
+	"@nestjs/cli":      {"nest "},
+	"@storybook/cli":   {"storybook"},
+	"@changesets/cli":  {"changeset"},
+}
+
+func toSet(words string) map[string]bool {
+	set := make(map[string]bool)
+	for _, w := range strings.Fields(words) {
+		set[w] = true
+	}
+	return set
+}
+
+// jsPackageName import belirtecini paket adına indirger ("@a/b/c" -> "@a/b", "lodash/fp" -> "lodash").
+// Göreli yollar, Node modülleri, "node:"/"virtual:" gibi şemalar ve tsconfig alias'ları paket değildir.
+func jsPackageName(spec string, aliases []string) (string, bool) {
+	if spec == "" || strings.ContainsAny(spec[:1], "./#~") || strings.Contains(spec, ":") || strings.HasPrefix(spec, "@/") {
+		return "", false
+	}
+	for _, a := range aliases {
+		if spec == a || strings.HasPrefix(spec, a+"/") {
+			return "", false
+		}
+	}
+	parts := strings.SplitN(spec, "/", 3)
+	if strings.HasPrefix(spec, "@") {
+		if len(parts) < 2 || parts[1] == "" {
+			return "", false
+		}
+		return parts[0] + "/" + parts[1], true
+	}
+	if nodeBuiltins[parts[0]] {
+		return "", false
+	}
+	return parts[0], true
+}
+
+// jsPathAliases tsconfig/jsconfig "paths" anahtarlarını döndürür ("@components/*" -> "@components").
+// Dosya yorum içerebildiği için JSON olarak değil regexp ile okunur.
+func jsPathAliases(dir string) []string {
+	var aliases []string
+	for _, name := range []string{"tsconfig.json", "jsconfig.json"} {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			continue
+		}
+		if m := jsPathsRe.FindSubmatch(data); m != nil {
+			for _, k := range jsPathKeyRe.FindAllSubmatch(m[1], -1) {
+				if a := strings.TrimSuffix(strings.TrimSuffix(string(k[1]), "*"), "/"); a != "" {
+					aliases = append(aliases, a)
+				}
+			}
+		}
+	}
+	return aliases
+}
+
+// jsManifest package.json'daki bağımlılıkları ve bağımlılık dışındaki alanları (scripts, eslintConfig...) okur
+func jsManifest(path string) (name string, declared, peers map[string]bool, rest string, err error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return "", nil, nil, "", err
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return "", nil, nil, "", fmt.Errorf("%s: %w", filepath.Base(path), err)
+	}
+	declared, peers = make(map[string]bool), make(map[string]bool)
+	for _, section := range []struct {
+		key string
+		dev bool
+	}{{"devDependencies", true}, {"peerDependencies", false}, {"optionalDependencies", false}, {"dependencies", false}} {
+		var deps map[string]string
+		if raw, ok := fields[section.key]; ok && json.Unmarshal(raw, &deps) == nil {
+			for dep := range deps {
+				declared[dep] = section.dev
+				if section.key == "peerDependencies" {
+					peers[dep] = true
+				}
+			}
+		}
+		delete(fields, section.key)
+	}
+	_ = json.Unmarshal(fields["name"], &name)
+	restData, _ := json.Marshal(fields)
+	return name, declared, peers, string(restData), nil
+}
+
+// jsToolConfigs klasördeki araç yapılandırmalarının (.eslintrc, .babelrc, postcss.config.js...) içeriği
+func jsToolConfigs(dir string) string {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return ""
+	}
+	var b strings.Builder
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || name == "package.json" || !(strings.HasPrefix(name, ".") || strings.Contains(name, "config") || strings.Contains(name, "rc.")) {
+			continue
+		}
+		if info, err := e.Info(); err != nil || info.Size() > maxSourceSize {
+			continue
+		}
+		if data, err := os.ReadFile(filepath.Join(dir, name)); err == nil {
+			b.Write(data)
+			b.WriteByte('\n')
+		}
+	}
+	return b.String()
+}
+
+// jsPeerRequirements kurulu paketlerin (node_modules) peerDependencies alanlarını toplar
+func jsPeerRequirements(dir, root string, names map[string]bool) map[string]bool {
+	required := make(map[string]bool)
+	for name := range names {
+		for d := filepath.Clean(dir); ; d = filepath.Dir(d) {
+			data, err := os.ReadFile(filepath.Join(d, "node_modules", filepath.FromSlash(name), "package.json"))
+			if err == nil {
+				var pkg struct {
+					PeerDependencies map[string]string `json:"peerDependencies"`
+				}
+				_ = json.Unmarshal(data, &pkg)
+				for peer := range pkg.PeerDependencies {
+					required[peer] = true
+				}
+				break
+			}
+			if d == root || d == filepath.Dir(d) {
+				break
+			}
+		}
+	}
+	return required
+}
+
+func (jsImports) Check(dir string, ignored map[string]bool) ([]domain.ImportIssue, int, error) {
+	self, declared, peers, rest, err := jsManifest(filepath.Join(dir, "package.json"))
+	if err != nil {
+		return nil, 0, err
+	}
+	// Workspace kökünde tanımlı paketler üyelerde de çözülür; ama onların kullanılmaması kökün sorunudur
+	local := make(map[string]bool, len(declared))
+	for name := range declared {
+		local[name] = true
+	}
+	root := ResolvePackageManager(dir).Root
+	if root != filepath.Clean(dir) {
+		if _, rootDeclared, _, _, err := jsManifest(filepath.Join(root, "package.json")); err == nil {
+			for name, dev := range rootDeclared {
+				if _, ok := declared[name]; !ok {
+					declared[name] = dev
+				}
+			}
+		}
+	}
+
+	aliases := jsPathAliases(dir)
+	used := make(usageMap)
+	files, err := walkSources(dir, ignored, []string{"package.json"}, func(name string) bool {
+		return jsSourceExts[filepath.Ext(name)]
+	}, func(rel string, data []byte) {
+		names := make(map[string]bool)
+		for _, re := range []*regexp.Regexp{jsStaticImportRe, jsCallImportRe} {
+			for _, m := range re.FindAllSubmatch(data, -1) {
+				if name, ok := jsPackageName(string(m[1]), aliases); ok && name != self {
+					names[name] = true
+				}
+			}
+		}
+		used.add(rel, jsDevFileRe.MatchString(rel), names)
+	})
+	if err != nil {
+		return nil, files, err
+	}
+
+	// Script'lerde veya araç yapılandırmalarında adı geçen paketler import edilmese de kullanılıyordur
+	text := rest + "\n" + jsToolConfigs(dir)
+	hasTSConfig := pathExists(filepath.Join(dir, "tsconfig.json"))
+	required := jsPeerRequirements(dir, root, local)
+	issues := compareImports("npm", dir, declared, used, func(name string) bool {
+		// Başka bir bağımlılığın peer bağımlılığı (next -> react-dom, eslint-plugin-x -> eslint) tanımlı kalmalıdır
+		if !local[name] || peers[name] || required[name] || strings.HasPrefix(name, "@types/") {
+			return true
+		}
+		if name == "typescript" && hasTSConfig {
+			return true
+		}
+		// eslint-plugin-react yapılandırmada "react", @scope/eslint-config "@scope" olarak geçer
+		short := name
+		for _, prefix := range []string{"eslint-config-", "eslint-plugin-", "prettier-plugin-", "babel-plugin-", "babel-preset-"} {
+			if i := strings.Index(name, prefix); i >= 0 {
+				short = strings.TrimSuffix(name[:i], "/") + name[i+len(prefix):]
+			}
+		}
+		return referencedIn(text, append([]string{name, short}, jsBinAliases[name]...)...)
+	})
+	return issues, files, nil
+}
+
+// --- go.mod ---
+
+type goImports struct{}
+
+func (goImports) Name() string { return "go" }
+
+func (goImports) Detect(dir string) bool {
+	return pathExists(filepath.Join(dir, "go.mod"))
+}
+
+// goModulePath go.mod'daki module satırını okur
+func goModulePath(modPath string) string {
+	f, err := os.Open(modPath)
+	if err != nil {
+		return ""
+	}
+	defer f.Close()
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		if fields := strings.Fields(sc.Text()); len(fields) >= 2 && fields[0] == "module" {
+			return strings.Trim(fields[1], `"`)
+		}
+	}
+	return ""
+}
+
+// goMissingModule go.mod'da karşılığı olmayan import yolundan modül yolunu tahmin eder
+// ("github.com/a/b/pkg/c" -> "github.com/a/b")
+func goMissingModule(importPath string) string {
+	parts := strings.Split(importPath, "/")
+	switch parts[0] {
+	case "github.com", "gitlab.com", "bitbucket.org", "golang.org", "codeberg.org":
+		if len(parts) > 3 {
+			return strings.Join(parts[:3], "/")
+		}
+	}
+	return importPath
+}
+
+func (goImports) Check(dir string, ignored map[string]bool) ([]domain.ImportIssue, int, error) {
+	modPath := filepath.Join(dir, "go.mod")
+	requires, _, err := readGoMod(modPath)
+	if err != nil {
+		return nil, 0, err
+	}
+	module := goModulePath(modPath)
+
+	// owner import yolunu sağlayan require'ı bulur (en uzun önek)
+	owner := func(importPath string) string {
+		best := ""
+		for _, r := range requires {
+			if (importPath == r.path || strings.HasPrefix(importPath, r.path+"/")) && len(r.path) > len(best) {
+				best = r.path
+			}
+		}
+		return best
+	}
+
+	used := make(usageMap)
+	missing := make(usageMap)
+	fset := token.NewFileSet()
+	files, err := walkSources(dir, ignored, []string{"go.mod"}, func(name string) bool {
+		return strings.HasSuffix(name, ".go")
+	}, func(rel string, data []byte) {
+		f, err := parser.ParseFile(fset, rel, data, parser.ImportsOnly)
+		if err != nil {
+			return
+		}
+		names, unknown := make(map[string]bool), make(map[string]bool)
+		for _, imp := range f.Imports {
+			path, err := strconv.Unquote(imp.Path.Value)
+			if err != nil {
+				continue
+			}
+			// Standart kütüphanenin ilk elemanında nokta yoktur
+			first, _, _ := strings.Cut(path, "/")
+			if !strings.Contains(first, ".") || path == module || strings.HasPrefix(path, module+"/") {
+				continue
+			}
+			if mod := owner(path); mod != "" {
+				names[mod] = true
+			} else {
+				unknown[goMissingModule(path)] = true
+			}
+		}
+		dev := strings.HasSuffix(rel, "_test.go")
+		used.add(rel, dev, names)
+		missing.add(rel, dev, unknown)
+	})
+	if err != nil {
+		return nil, files, err
+	}
+
+	// Go'da dev bölümü yoktur; require'lar doğrudan veya "// indirect" olarak işaretlenir
+	var issues []domain.ImportIssue
+	for name, use := range missing {
+		issues = append(issues, domain.ImportIssue{Ecosystem: "go", Name: name, Issue: domain.ImportMissing, Expected: "direct", File: use.first, Files: use.files, Dir: dir})
+	}
+	for _, r := range requires {
+		use, ok := used[r.path]
+		switch {
+		case ok && r.indirect:
+			issues = append(issues, domain.ImportIssue{Ecosystem: "go", Name: r.path, Issue: domain.ImportMisplaced, Section: "indirect", Expected: "direct", File: use.first, Files: use.files, Dir: dir})
+		case !ok && !r.indirect:
+			issues = append(issues, domain.ImportIssue{Ecosystem: "go", Name: r.path, Issue: domain.ImportUnused, Section: "direct", Dir: dir})
+		}
+	}
+	return issues, files, nil
+}
+
+// --- requirements.txt / pyproject.toml ---
+
+type pyImports struct{}
+
+func (pyImports) Name() string { return "pip" }
+
+func (pyImports) Detect(dir string) bool {
+	return pathExists(filepath.Join(dir, "requirements.txt")) || pathExists(filepath.Join(dir, "pyproject.toml"))
+}
+
+var (
+	// import a, b.c as d
+	pyImportRe = regexp.MustCompile(`(?m)^[ \t]*import[ \t]+([\w.]+(?:[ \t]+as[ \t]+\w+)?(?:[ \t]*,[ \t]*[\w.]+(?:[ \t]+as[ \t]+\w+)?)*)`)
+	// from a.b import c (göreli "from . import x" hariç)
+	pyFromRe      = regexp.MustCompile(`(?m)^[ \t]*from[ \t]+(\w[\w.]*)[ \t]+import\b`)
+	pyDevFileRe   = regexp.MustCompile(`(^|/)(tests?|testing|docs|benchmarks)/|(^|/)(test_[^/]*|[^/]*_test|conftest|setup|noxfile|fabfile)\.py$`)
+	pyToolTableRe = regexp.MustCompile(`(?m)^\[tool\.([\w-]+)`)
+)
+
+// pyModuleAliases import adı dağıtım adından farklı olan yaygın paketler
+var pyModuleAliases = map[string]string{
+	"yaml": "pyyaml", "PIL": "pillow", "cv2": "opencv-python", "sklearn": "scikit-learn", "bs4": "beautifulsoup4",
+	"dateutil": "python-dateutil", "dotenv": "python-dotenv", "jwt": "pyjwt", "jose": "python-jose",
+	"multipart": "python-multipart", "magic": "python-magic", "Crypto": "pycryptodome", "OpenSSL": "pyopenssl",
+	"google.protobuf": "protobuf", "attr": "attrs", "serial": "pyserial", "usb": "pyusb", "MySQLdb": "mysqlclient",
+	"docx": "python-docx", "pptx": "python-pptx", "telegram": "python-telegram-bot",
+	"slugify": "python-slugify", "socketio": "python-socketio", "engineio": "python-engineio", "zmq": "pyzmq",
+	"git": "gitpython", "skimage": "scikit-image", "fitz": "pymupdf", "win32api": "pywin32", "pkg_resources": "setuptools",
+}
+
+// pyStdlib Python standart kütüphanesinin üst düzey modülleri
+var pyStdlib = toSet(`__future__ _thread abc aifc argparse array ast asynchat asyncio asyncore atexit audioop base64
+	bdb binascii bisect builtins bz2 calendar cgi cgitb chunk cmath cmd code codecs codeop collections colorsys
+	compileall concurrent configparser contextlib contextvars copy copyreg cProfile crypt csv ctypes curses dataclasses
+	datetime dbm decimal difflib dis distutils doctest email encodings ensurepip enum errno faulthandler fcntl filecmp
+	fileinput fnmatch fractions ftplib functools gc getopt getpass gettext glob graphlib grp gzip hashlib heapq hmac
+	html http idlelib imaplib imghdr imp importlib inspect io ipaddress itertools json keyword lib2to3 linecache locale
+	logging lzma mailbox mailcap marshal math mimetypes mmap modulefinder msilib msvcrt multiprocessing netrc nis
+	nntplib ntpath numbers operator optparse os ossaudiodev pathlib pdb pickle pickletools pipes pkgutil platform
+	plistlib poplib posix posixpath pprint profile pstats pty pwd py_compile pyclbr pydoc queue quopri random re
+	readline reprlib resource rlcompleter runpy sched secrets select selectors shelve shlex shutil signal site smtpd
+	smtplib sndhdr socket socketserver spwd sqlite3 sre_compile sre_constants sre_parse ssl stat statistics string
+	stringprep struct subprocess sunau symtable sys sysconfig syslog tabnanny tarfile telnetlib tempfile termios
+	textwrap threading time timeit tkinter token tokenize tomllib trace traceback tracemalloc tty turtle turtledemo
+	types typing unicodedata unittest urllib uu uuid venv warnings wave weakref webbrowser winreg winsound wsgiref
+	xdrlib xml xmlrpc zipapp zipfile zipimport zlib zoneinfo`)
+
+// pyLocalModules projenin kendi modülleri (klasördeki ve src/ altındaki .py dosyaları ve paketler)
+func pyLocalModules(dir string) map[string]bool {
+	local := make(map[string]bool)
+	for _, base := range []string{dir, filepath.Join(dir, "src")} {
+		entries, err := os.ReadDir(base)
+		if err != nil {
+			continue
+		}
+		for _, e := range entries {
+			name := e.Name()
+			switch {
+			case e.IsDir() && !strings.HasPrefix(name, "."):
+				// __init__.py olmayan klasörler de namespace paketi olabilir
+				local[name] = true
+			case strings.HasSuffix(name, ".py"):
+				local[strings.TrimSuffix(name, ".py")] = true
+			}
+		}
+	}
+	return local
+}
+
+// pyInstalledModules sanal ortamdaki paketlerin sağladığı modülleri (top_level.txt) dağıtım adına eşler
+func pyInstalledModules(dir string) map[string]string {
+	modules := make(map[string]string)
+	var patterns []string
+	for _, venv := range []string{".venv", "venv", "env"} {
+		patterns = append(patterns,
+			filepath.Join(dir, venv, "lib", "python*", "site-packages", "*.dist-info"),
+			filepath.Join(dir, venv, "Lib", "site-packages", "*.dist-info"))
+	}
+	for _, pattern := range patterns {
+		infos, _ := filepath.Glob(pattern)
+		for _, info := range infos {
+			data, err := os.ReadFile(filepath.Join(info, "top_level.txt"))
+			if err != nil {
+				continue
+			}
+			// "PyYAML-6.0.1.dist-info" -> pyyaml
+			dist, _, _ := strings.Cut(filepath.Base(info), "-")
+			for _, mod := range strings.Fields(string(data)) {
+				if _, ok := modules[mod]; !ok {
+					modules[mod] = normalizePyName(dist)
+				}
+			}
+		}
+	}
+	return modules
+}
+
+// pyToolConfigs script ve araç yapılandırmalarının içeriği (pytest, black gibi import edilmeyen araçlar için)
+func pyToolConfigs(dir string) string {
+	var b strings.Builder
+	for _, name := range []string{"pyproject.toml", "setup.cfg", "tox.ini", "pytest.ini", ".pre-commit-config.yaml", "Makefile", "justfile", "Procfile", "Dockerfile", "noxfile.py", ".flake8", "mypy.ini"} {
+		if data, err := os.ReadFile(filepath.Join(dir, name)); err == nil {
+			if name == "pyproject.toml" {
+				// Bağımlılık listeleri her paketi içerdiği için sadece [tool.x] başlıkları kullanılır
+				for _, m := range pyToolTableRe.FindAllSubmatch(data, -1) {
+					b.WriteString(string(m[1]) + "\n")
+				}
+				continue
+			}
+			b.Write(data)
+			b.WriteByte('\n')
+		}
+	}
+	return strings.ToLower(b.String())
+}
+
+func (pyImports) Check(dir string, ignored map[string]bool) ([]domain.ImportIssue, int, error) {
+	declared := pythonRequirements(dir)
+	local := pyLocalModules(dir)
+	installed := pyInstalledModules(dir)
+
+	// distribution import edilen modülün hangi pakete ait olduğunu bulur: önce kurulu
+	// paketler ve aynı adla tanımlı bağımlılık, bilinen takma adlar sadece son çare
+	distribution := func(module string) string {
+		if dist, ok := installed[module]; ok {
+			return dist
+		}
+		if _, ok := declared[normalizePyName(module)]; ok {
+			return normalizePyName(module)
+		}
+		if dist, ok := pyModuleAliases[module]; ok {
+			return dist
+		}
+		return normalizePyName(module)
+	}
+
+	used := make(usageMap)
+	files, err := walkSources(dir, ignored, []string{"pyproject.toml", "requirements.txt"}, func(name string) bool {
+		return strings.HasSuffix(name, ".py")
+	}, func(rel string, data []byte) {
+		var modules []string
+		for _, m := range pyImportRe.FindAllSubmatch(data, -1) {
+			for _, part := range strings.Split(string(m[1]), ",") {
+				if f := strings.Fields(part); len(f) > 0 {
+					modules = append(modules, f[0])
+				}
+			}
+		}
+		for _, m := range pyFromRe.FindAllSubmatch(data, -1) {
+			modules = append(modules, string(m[1]))
+		}
+		names := make(map[string]bool)
+		for _, mod := range modules {
+			top, _, _ := strings.Cut(mod, ".")
+			if pyStdlib[top] || local[top] {
+				continue
+			}
+			// "google.protobuf" gibi namespace paketleri tam adla eşlenir; üst düzey
+			// modüller ise önce tanımlı bağımlılıklarla eşleşsin diye distribution'a bırakılır
+			if dist, ok := pyModuleAliases[mod]; ok && mod != top {
+				names[dist] = true
+				continue
+			}
+			names[distribution(top)] = true
+		}
+		used.add(rel, pyDevFileRe.MatchString(rel), names)
+	})
+	if err != nil {
+		return nil, files, err
+	}
+
+	text := pyToolConfigs(dir)
+	issues := compareImports("pip", dir, declared, used, func(name string) bool {
+		// types-requests gibi tip paketleri ve çalıştırılan araçlar (gunicorn, pytest-cov) import edilmez
+		return strings.HasPrefix(name, "types-") || strings.HasSuffix(name, "-stubs") ||
+			referencedIn(text, name, strings.ReplaceAll(name, "-", "_"))
+	})
+	return issues, files, nil
+}
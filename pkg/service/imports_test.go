@@ -0,0 +1,148 @@
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"devterminal/pkg/domain"
+)
+
+// writeTree verilen göreli yollardaki dosyaları dir altına yazar
+func writeTree(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for rel, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(rel))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// issueSummary sorunları "tür ad bölüm->beklenen" biçiminde sıralı döndürür
+func issueSummary(issues []domain.ImportIssue) []string {
+	var out []string
+	for _, i := range issues {
+		out = append(out, string(i.Issue)+" "+i.Name+" "+i.Section+"->"+i.Expected)
+	}
+	sort.Strings(out)
+	return out
+}
+
+func checkIssues(t *testing.T, src ImportSource, dir string, want []string) {
+	t.Helper()
+	issues, _, err := src.Check(dir, nil)
+	if err != nil {
+		t.Fatalf("%s Check: %v", src.Name(), err)
+	}
+	sort.Strings(want)
+	if got := issueSummary(issues); strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("%s sorunları:\n%s\nbeklenen:\n%s", src.Name(), strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestJSPackageName(t *testing.T) {
+	aliases := []string{"@components", "~lib"}
+	tests := map[string]string{
+		"react":                "react",
+		"lodash/fp":            "lodash",
+		"@scope/pkg":           "@scope/pkg",
+		"@scope/pkg/sub/path":  "@scope/pkg",
+		"./local":              "",
+		"../up":                "",
+		"/abs":                 "",
+		"#internal":            "",
+		"@/src/x":              "",
+		"node:fs":              "",
+		"virtual:pwa-register": "",
+		"fs":                   "",
+		"path/posix":           "",
+		"@components":          "",
+		"@components/Button":   "",
+		"@componentsx/Button":  "@componentsx/Button",
+		"@scope":               "",
+	}
+	for spec, want := range tests {
+		got, ok := jsPackageName(spec, aliases)
+		if got != want || ok != (want != "") {
+			t.Errorf("jsPackageName(%q) = (%q, %v), beklenen %q", spec, got, ok, want)
+		}
+	}
+}
+
+func TestJSImportsCheck(t *testing.T) {
+	dir := t.TempDir()
+	writeTree(t, dir, map[string]string{
+		"package.json": `{
+  "name": "app",
+  "scripts": {"lint": "eslint ."},
+  "dependencies": {"react": "^18.0.0", "left-pad": "^1.0.0", "jest-dom": "^1.0.0"},
+  "devDependencies": {"axios": "^1.0.0", "eslint": "^9.0.0", "typescript": "^5.0.0", "@types/node": "^20.0.0"}
+}`,
+		"tsconfig.json":             `{"compilerOptions": {"paths": {"@ui/*": ["src/ui/*"]}}}`,
+		"src/app.tsx":               "import React from 'react'\nimport { get } from \"axios\"\nimport Button from '@ui/Button'\nimport './style.css'\nimport fs from 'node:fs'\nconst x = require('dayjs')\n",
+		"src/lazy.ts":               "export * from 'app/self'\nconst m = import('chalk')\n",
+		"src/app.test.tsx":          "import '@testing-library/jest-dom'\nimport 'jest-dom'\n",
+		"node_modules/x/index.js":   "import 'should-not-be-scanned'\n",
+		"packages/sub/package.json": `{"name": "sub"}`,
+		"packages/sub/index.js":     "import 'sub-only'\n",
+	})
+	checkIssues(t, jsImports{}, dir, []string{
+		"missing dayjs ->prod",
+		"missing chalk ->prod",
+		"missing @testing-library/jest-dom ->dev",
+		"misplaced axios dev->prod",
+		"misplaced jest-dom prod->dev",
+		"unused left-pad prod->",
+	})
+}
+
+func TestGoImportsCheck(t *testing.T) {
+	dir := t.TempDir()
+	writeTree(t, dir, map[string]string{
+		"go.mod": `module example.com/app
+
+go 1.22
+
+require (
+	github.com/used/lib v1.0.0
+	github.com/unused/lib v1.0.0
+	github.com/indirect/lib v1.0.0 // indirect
+	github.com/test/only v1.0.0
+)
+`,
+		"main.go":       "package main\n\nimport (\n\t\"fmt\"\n\t\"example.com/app/internal/x\"\n\t\"github.com/used/lib/sub\"\n\t\"github.com/indirect/lib\"\n\t\"github.com/missing/mod/pkg/deep\"\n)\n",
+		"main_test.go":  "package main\n\nimport \"github.com/test/only\"\n",
+		"tool/go.mod":   "module example.com/tool\n",
+		"tool/main.go":  "package main\n\nimport \"github.com/other/mod\"\n",
+		"vendor/a/a.go": "package a\n\nimport \"github.com/vendored/mod\"\n",
+	})
+	checkIssues(t, goImports{}, dir, []string{
+		"missing github.com/missing/mod ->direct",
+		"misplaced github.com/indirect/lib indirect->direct",
+		"unused github.com/unused/lib direct->",
+	})
+}
+
+func TestPyImportsCheck(t *testing.T) {
+	dir := t.TempDir()
+	writeTree(t, dir, map[string]string{
+		"requirements.txt":     "requests>=2\nPyYAML==6.0\njwt==1.3.1\nflask\ngunicorn\n",
+		"requirements-dev.txt": "pytest\nblack\n",
+		"Procfile":             "web: gunicorn app:app\n",
+		"app.py":               "import os, json\nimport requests as r\nimport yaml\nimport jwt\nfrom mypkg.util import helper\nfrom . import sibling\nimport numpy\n",
+		"mypkg/__init__.py":    "",
+		"tests/test_app.py":    "import pytest\nimport flask\n",
+	})
+	checkIssues(t, pyImports{}, dir, []string{
+		// jwt aynı adla tanımlı olduğundan pyjwt takma adına çevrilmez
+		"missing numpy ->prod",
+		"misplaced flask prod->dev",
+		"unused black dev->",
+	})
+}
@@ -1,13 +1,9 @@
 package service
 
 import (
-	"bytes"
 	"fmt"
-	"os"
 	"os/exec"
-	"path/filepath"
 	"strings"
-	"text/template"
 	"time"
 
 	"devterminal/pkg/config"
@@ -15,49 +11,48 @@ import (
 )
 
 type Launcher struct {
-	Config *domain.Config
+	Config     *domain.Config
+	Terminal   TerminalBackend
+	Supervisor *Supervisor
+	History    *History
 }
 
 func NewLauncher(cfg *domain.Config) *Launcher {
-	return &Launcher{Config: cfg}
+	return &Launcher{
+		Config:     cfg,
+		Terminal:   NewTerminalBackend(cfg.Terminal),
+		Supervisor: NewSupervisor(),
+		History:    NewHistory(),
+	}
 }
 
-// LaunchProject opens the project in Windows Terminal using the configured template
+// LaunchProject opens the project using the configured template,
+// or through the terminal backend when no template is set
 func (l *Launcher) LaunchProject(p *domain.Project, mode string) error {
-	// Update LastOpened time
-	if l.Config.LastOpened == nil {
-		l.Config.LastOpened = make(map[string]time.Time)
+	name, cmdTmpl, err := l.launchTemplate(mode)
+	if err != nil {
+		return err
 	}
-	l.Config.LastOpened[strings.ToLower(p.Path)] = time.Now()
-	// Save config silently
-	_ = config.SaveConfig(l.Config)
+	l.touchLastOpened(p)
 
-	var cmdTmpl string
-	switch mode {
-	case "frontend":
-		cmdTmpl = l.Config.Commands.LaunchFrontend
-	case "backend":
-		cmdTmpl = l.Config.Commands.LaunchBackend
-	case "full":
-		cmdTmpl = l.Config.Commands.LaunchFull
-	default:
-		return fmt.Errorf("unknown mode: %s", mode)
-	}
+	entry := domain.HistoryEntry{Kind: domain.HistoryLaunch, Name: launchModeName(mode), Target: mode}
 
-	// Parse template
-	tmpl, err := template.New("cmd").Parse(cmdTmpl)
-	if err != nil {
+	// Şablon tanımlı değilse terminal backend'i kullan
+	if strings.TrimSpace(cmdTmpl) == "" {
+		reqs := projectRequests(p, mode)
+		err := openInTerminal(l.Terminal, reqs...)
+		l.recordLaunched(entry, p, reqs, err)
 		return err
 	}
 
-	var cmdStr bytes.Buffer
-	if err := tmpl.Execute(&cmdStr, p); err != nil {
+	// Expand template (errors carry the offending field, see PreviewLaunch)
+	expandedCmd, err := renderTemplate(name, cmdTmpl, l.newTemplateData(p, mode))
+	if err != nil {
 		return err
 	}
 
 	// Parse the command string into executable and args
 	// We need to support quoted arguments.
-	expandedCmd := cmdStr.String()
 	args := parseArgs(expandedCmd)
 
 	if len(args) == 0 {
@@ -67,14 +62,77 @@ func (l *Launcher) LaunchProject(p *domain.Project, mode string) error {
 	// Execute directly, bypassing cmd /C
 	// args[0] is executable (e.g. wt.exe), args[1:] are arguments
 	c := exec.Command(args[0], args[1:]...)
-	return c.Start()
+	err = c.Start()
+	entry.Command = expandedCmd
+	l.recordLaunched(entry, p, nil, err)
+	return err
 }
 
-// parseArgs splits a string into arguments, respecting quotes
+// launchModeName returns the display name of a launch mode for the history
+func launchModeName(mode string) string {
+	switch mode {
+	case "frontend":
+		return "Frontend"
+	case "backend":
+		return "Backend"
+	case "full":
+		return "Full Stack"
+	}
+	return mode
+}
+
+// StartSupervised starts the project's frontend and/or backend as supervised child processes
+func (l *Launcher) StartSupervised(p *domain.Project, mode string) ([]*ManagedProcess, error) {
+	reqs := projectRequests(p, mode)
+	if len(reqs) == 0 {
+		return nil, fmt.Errorf("unknown mode: %s", mode)
+	}
+	l.touchLastOpened(p)
+
+	restart := RestartSpecFromOverride(l.Config.ProjectOverrides[strings.ToLower(p.Path)])
+
+	var procs []*ManagedProcess
+	for _, r := range reqs {
+		mp, err := l.Supervisor.Start(ProcessSpec{Name: r.Title, ProjectPath: p.Path, Dir: r.Dir, Command: r.Command, Restart: restart})
+		l.trackProcess(domain.HistoryEntry{Kind: domain.HistoryLaunch, Name: r.Title, Target: mode}, p, mp, err)
+		if mp != nil {
+			procs = append(procs, mp)
+		}
+		if err != nil {
+			return procs, err
+		}
+	}
+	return procs, nil
+}
+
+// touchLastOpened updates LastOpened time and saves config silently
+func (l *Launcher) touchLastOpened(p *domain.Project) {
+	if l.Config.LastOpened == nil {
+		l.Config.LastOpened = make(map[string]time.Time)
+	}
+	l.Config.LastOpened[strings.ToLower(p.Path)] = time.Now()
+	_ = config.SaveConfig(l.Config)
+}
+
+// projectRequests builds the terminal requests for the given launch mode
+func projectRequests(p *domain.Project, mode string) []TerminalRequest {
+	var reqs []TerminalRequest
+	if (mode == "frontend" || mode == "full") && p.FrontendPath != "" {
+		reqs = append(reqs, TerminalRequest{Title: p.Name + " Frontend", Dir: p.FrontendPath, Command: p.FrontendCmd})
+	}
+	if (mode == "backend" || mode == "full") && p.BackendPath != "" {
+		reqs = append(reqs, TerminalRequest{Title: p.Name + " Backend", Dir: p.BackendPath, Command: p.BackendCmd})
+	}
+	return reqs
+}
+
+// parseArgs splits a string into arguments, respecting quotes.
+// A quoted empty string ("") is kept as an empty argument.
 func parseArgs(cmd string) []string {
 	var args []string
 	var current []rune
 	inQuote := false
+	quoted := false
 	quoteChar := rune(0)
 
 	for _, r := range cmd {
@@ -88,125 +146,83 @@ func parseArgs(cmd string) []string {
 			switch r {
 			case '"', '\'':
 				inQuote = true
+				quoted = true
 				quoteChar = r
 			case ' ', '\t':
-				if len(current) > 0 {
+				if len(current) > 0 || quoted {
 					args = append(args, string(current))
 					current = nil
+					quoted = false
 				}
 			default:
 				current = append(current, r)
 			}
 		}
 	}
-	if len(current) > 0 {
+	if len(current) > 0 || quoted {
 		args = append(args, string(current))
 	}
 	return args
 }
 
-// LaunchPrisma opens Prisma Studio for the project
-func (l *Launcher) LaunchPrisma(p *domain.Project) error {
-	path := p.Path
-	if p.PrismaPath != "" {
-		path = p.PrismaPath
-	}
-	cmdStr := fmt.Sprintf(`wt -w 0 nt --title "Prisma Studio" -d "%s" cmd /k "npx prisma studio"`, path)
-
-	args := parseArgs(cmdStr)
-	if len(args) == 0 {
-		return fmt.Errorf("failed to create prisma command")
+// LaunchTool opens a registry tool (Prisma Studio, Storybook, ...) in its working dir.
+// The command is expanded like the launch templates, with the tool dir's package manager.
+func (l *Launcher) LaunchTool(p *domain.Project, t domain.ProjectTool) error {
+	data := l.newTemplateData(p, "")
+	data.setPackageManager(ResolvePackageManager(t.Dir))
+	cmd, err := renderShellTemplate("tools."+t.ID+".command", t.Command, data)
+	if err != nil {
+		return err
 	}
-
-	c := exec.Command(args[0], args[1:]...)
-	return c.Start()
+	return l.openTool(p, TerminalRequest{Title: t.Name, Dir: t.Dir, Command: strings.TrimSpace(cmd)})
 }
 
-// LaunchDrizzle opens Drizzle Studio
-func (l *Launcher) LaunchDrizzle(p *domain.Project) error {
-	path := p.Path
-	if p.DrizzlePath != "" {
-		path = p.DrizzlePath
-	}
-	cmdStr := fmt.Sprintf(`wt -w 0 nt --title "Drizzle Studio" -d "%s" cmd /k "npx drizzle-kit studio"`, path)
-	return l.runCmd(cmdStr)
+// openTool opens a database/UI tool in a new terminal tab and records it in the history
+func (l *Launcher) openTool(p *domain.Project, r TerminalRequest) error {
+	err := openInTerminal(l.Terminal, r)
+	l.recordLaunched(domain.HistoryEntry{Kind: domain.HistoryTool, Name: r.Title}, p, []TerminalRequest{r}, err)
+	return err
 }
 
-// LaunchHasura opens Hasura Console
-func (l *Launcher) LaunchHasura(p *domain.Project) error {
-	path := p.Path
-	if p.HasuraPath != "" {
-		path = p.HasuraPath
-	}
-	cmdStr := fmt.Sprintf(`wt -w 0 nt --title "Hasura Console" -d "%s" cmd /k "hasura console"`, path)
-	return l.runCmd(cmdStr)
+// LaunchTask opens a new terminal tab to run the selected task
+func (l *Launcher) LaunchTask(p domain.Project, t domain.Task) error {
+	r := taskRequest(t)
+	err := openInTerminal(l.Terminal, r)
+	l.recordLaunched(taskEntry(t), &p, []TerminalRequest{r}, err)
+	return err
 }
 
-// LaunchSupabase opens Supabase Dashboard
-func (l *Launcher) LaunchSupabase(p *domain.Project) error {
-	path := p.Path
-	if p.SupabasePath != "" {
-		path = p.SupabasePath
-	}
-	cmdStr := fmt.Sprintf(`wt -w 0 nt --title "Supabase Status" -d "%s" cmd /k "npx supabase status"`, path)
-	return l.runCmd(cmdStr)
+// RunTaskSupervised runs the selected task as a supervised process
+func (l *Launcher) RunTaskSupervised(p domain.Project, t domain.Task) (*ManagedProcess, error) {
+	r := taskRequest(t)
+	mp, err := l.Supervisor.Start(ProcessSpec{Name: p.Name + " › " + r.Title, ProjectPath: p.Path, Dir: r.Dir, Command: r.Command})
+	l.trackProcess(taskEntry(t), &p, mp, err)
+	return mp, err
 }
 
-// LaunchStorybook opens Storybook
-func (l *Launcher) LaunchStorybook(p *domain.Project) error {
-	path := p.Path
-	if p.StorybookPath != "" {
-		path = p.StorybookPath
-	}
-	cmdStr := fmt.Sprintf(`wt -w 0 nt --title "Storybook" -d "%s" cmd /k "npm run storybook"`, path)
-	return l.runCmd(cmdStr)
-}
-
-// LaunchScript opens a new terminal tab to run the selected package.json script
-// LaunchScript opens a new terminal tab to run the selected package.json script
-func (l *Launcher) LaunchScript(p domain.Project, scriptName, scriptCmd string) error {
-	workingDir := p.Path
-	actualScriptName := scriptName
-
-	// Determine correct working directory and clean script name
-	if strings.HasPrefix(scriptName, "client:") {
-		workingDir = p.FrontendPath
-		actualScriptName = strings.TrimPrefix(scriptName, "client:")
-	} else if strings.HasPrefix(scriptName, "server:") {
-		workingDir = p.BackendPath
-		actualScriptName = strings.TrimPrefix(scriptName, "server:")
-	}
-
-	pm := l.getPackageManager(workingDir)
-	runCmd := fmt.Sprintf("%s run %s", pm, actualScriptName)
-
-	// Create title: "npm run dev"
-	title := fmt.Sprintf("%s %s", pm, actualScriptName)
+// RunTaskInline runs the task as a standalone process whose output is
+// streamed into the Task Runner (not registered with the supervisor)
+func (l *Launcher) RunTaskInline(p domain.Project, t domain.Task) (*ManagedProcess, error) {
+	r := taskRequest(t)
+	mp := newManagedProcess(0, ProcessSpec{Name: r.Title, ProjectPath: p.Path, Dir: r.Dir, Command: r.Command})
+	err := mp.start()
+	l.trackProcess(taskEntry(t), &p, mp, err)
+	return mp, err
+}
 
-	// Use workingDir instead of p.Path
-	cmdStr := fmt.Sprintf(`wt -w 0 nt --title "%s" -d "%s" cmd /k "%s"`, title, workingDir, runCmd)
-	return l.runCmd(cmdStr)
+// taskEntry builds the history entry for a task run
+func taskEntry(t domain.Task) domain.HistoryEntry {
+	return domain.HistoryEntry{Kind: domain.HistoryTask, Name: t.Name, Target: t.Source}
 }
 
-func (l *Launcher) getPackageManager(path string) string {
-	if _, err := os.Stat(filepath.Join(path, "bun.lockb")); err == nil {
-		return "bun"
-	}
-	if _, err := os.Stat(filepath.Join(path, "pnpm-lock.yaml")); err == nil {
-		return "pnpm"
-	}
-	if _, err := os.Stat(filepath.Join(path, "yarn.lock")); err == nil {
-		return "yarn"
-	}
-	return "npm"
+// taskRequest builds the terminal request for a task using its source's runner
+func taskRequest(t domain.Task) TerminalRequest {
+	// Title: "npm run dev", "make build"
+	return TerminalRequest{Title: t.Run, Dir: t.Dir, Command: t.Run}
 }
 
-// runCmd helper
-func (l *Launcher) runCmd(cmdStr string) error {
-	args := parseArgs(cmdStr)
-	if len(args) == 0 {
-		return fmt.Errorf("failed to create command")
-	}
-	c := exec.Command(args[0], args[1:]...)
-	return c.Start()
+// LaunchNgrok opens an ngrok http tunnel for the given port
+func (l *Launcher) LaunchNgrok(exe, port string) error {
+	cmd := quoteShellArg(exe) + " http " + port
+	return l.openTool(nil, TerminalRequest{Title: "Ngrok " + port, Dir: ".", Command: cmd})
 }
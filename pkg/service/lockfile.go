@@ -0,0 +1,652 @@
+package service
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"devterminal/pkg/domain"
+
+	"gopkg.in/yaml.v3"
+)
+
+// DriftSource bir ekosistemin kilit dosyasını ağa çıkmadan manifestle karşılaştırır (offline doktor)
+type DriftSource interface {
+	// Name tabloda gösterilen ekosistem adı
+	Name() string
+	// Detect klasörde bu ekosistemin manifesti var mı
+	Detect(dir string) bool
+	// Drift manifest, kilit dosyası ve kurulu paketler arasındaki uyumsuzlukları döndürür
+	Drift(dir string) ([]domain.LockfileDrift, error)
+}
+
+// DriftSources desteklenen kaynakları tabloda görünecekleri sırayla döndürür
+func DriftSources() []DriftSource {
+	return []DriftSource{
+		jsDrift{},
+		goDrift{},
+	}
+}
+
+// highestVersion listedeki en yüksek semver sürümü döndürür (hiçbiri semver değilse ilki)
+func highestVersion(versions []string) string {
+	best, bestV := "", Semver{}
+	for _, v := range versions {
+		sv, ok := ParseSemver(v)
+		if !ok {
+			continue
+		}
+		if best == "" || sv.Compare(bestV) > 0 {
+			best, bestV = v, sv
+		}
+	}
+	if best == "" && len(versions) > 0 {
+		return versions[0]
+	}
+	return best
+}
+
+// --- package.json (package-lock.json, pnpm-lock.yaml, yarn.lock) ---
+
+type jsDrift struct{}
+
+func (jsDrift) Name() string { return "npm" }
+
+func (jsDrift) Detect(dir string) bool {
+	return pathExists(filepath.Join(dir, "package.json"))
+}
+
+// lockedVersion kilit dosyasında paketin çözümlendiği sürümü bulur.
+// found=false ise kayıt yoktur; sürüm boşsa paket yereldir (link, workspace) ve karşılaştırılmaz.
+type lockedVersion func(name, spec string) (version string, found bool)
+
+// jsLockLookup dizinin paket yöneticisini bulur ve kilit dosyasını o dizin için okur
+func jsLockLookup(dir string) (PackageManager, lockedVersion, error) {
+	pm := ResolvePackageManager(dir)
+	if pm.Lockfile == "" {
+		return pm, nil, fmt.Errorf("kilit dosyası bulunamadı (%s çalıştırın)", commandLine(pm.Install()))
+	}
+	lockPath := filepath.Join(pm.Root, pm.Lockfile)
+	// Workspace üyeleri kilit dosyasında köke göre yoluyla tutulur ("packages/web")
+	rel, err := filepath.Rel(pm.Root, dir)
+	if err != nil {
+		rel = "."
+	}
+	rel = filepath.ToSlash(rel)
+
+	var lookup lockedVersion
+	switch pm.Lockfile {
+	case "package-lock.json", "npm-shrinkwrap.json":
+		lookup, err = readNpmLock(lockPath, rel)
+	case "pnpm-lock.yaml":
+		lookup, err = readPnpmLock(lockPath, rel)
+	case "yarn.lock":
+		lookup, err = readYarnLock(lockPath)
+	default:
+		return pm, nil, fmt.Errorf("%s offline olarak okunamıyor", pm.Lockfile)
+	}
+	if err != nil {
+		return pm, nil, fmt.Errorf("%s okunamadı: %v", pm.Lockfile, err)
+	}
+	return pm, lookup, nil
+}
+
+func (jsDrift) Drift(dir string) ([]domain.LockfileDrift, error) {
+	pm, lookup, err := jsLockLookup(dir)
+	if err != nil {
+		return nil, err
+	}
+	lockPath := filepath.Join(pm.Root, pm.Lockfile)
+
+	data, err := os.ReadFile(filepath.Join(dir, "package.json"))
+	if err != nil {
+		return nil, err
+	}
+	var pkg struct {
+		Dependencies         map[string]string `json:"dependencies"`
+		DevDependencies      map[string]string `json:"devDependencies"`
+		OptionalDependencies map[string]string `json:"optionalDependencies"`
+	}
+	if err := json.Unmarshal(data, &pkg); err != nil {
+		return nil, fmt.Errorf("package.json parse edilemedi: %v", err)
+	}
+
+	// node_modules hiç yoksa (install yapılmamış) kurulum karşılaştırması yapılmaz
+	checkInstalled := pathExists(filepath.Join(dir, "node_modules")) || pathExists(filepath.Join(pm.Root, "node_modules"))
+
+	var drift []domain.LockfileDrift
+	check := func(deps map[string]string, dev, optional bool) {
+		for name, spec := range deps {
+			if localSpec(spec) {
+				continue
+			}
+			d := domain.LockfileDrift{
+				Ecosystem: pm.Name, Name: name, Declared: spec, Dev: dev, Lockfile: lockPath, Dir: dir,
+			}
+			locked, found := lookup(name, spec)
+			d.Locked = locked
+			_, lockedSemver := ParseSemver(locked)
+			if checkInstalled {
+				d.Installed = installedVersion(dir, pm.Root, name)
+			}
+			switch {
+			case !found:
+				d.Issue = domain.DriftMissing
+			case !rangeAllows(spec, locked):
+				d.Issue = domain.DriftUnsatisfied
+			// Platforma özel optional paketlerin kurulmamış olması normaldir
+			case checkInstalled && lockedSemver && d.Installed != locked && !(optional && d.Installed == ""):
+				d.Issue = domain.DriftInstalled
+			default:
+				continue
+			}
+			drift = append(drift, d)
+		}
+	}
+	check(pkg.Dependencies, false, false)
+	check(pkg.OptionalDependencies, false, true)
+	check(pkg.DevDependencies, true, false)
+	return drift, nil
+}
+
+// localSpec workspace / dosya bağımlılıkları kilit dosyasıyla karşılaştırılmaz
+func localSpec(spec string) bool {
+	for _, prefix := range []string{"workspace:", "link:", "file:", "portal:"} {
+		if strings.HasPrefix(spec, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// rangeAllows kilitli sürüm manifestteki aralığa uyuyor mu.
+// Aralık (git URL, dist-tag) veya sürüm semver değilse karşılaştırılamaz, uyumlu sayılır.
+func rangeAllows(spec, version string) bool {
+	v, ok := ParseSemver(version)
+	if !ok {
+		return true
+	}
+	rng, err := ParseSemverRange(npmRangeSpec(spec))
+	if err != nil {
+		return true
+	}
+	return rng.Contains(v)
+}
+
+// installedVersion paketin node_modules'taki sürümünü bulur; Node gibi workspace köküne kadar yukarı çıkar
+func installedVersion(dir, root, name string) string {
+	for d := dir; ; d = filepath.Dir(d) {
+		data, err := os.ReadFile(filepath.Join(d, "node_modules", filepath.FromSlash(name), "package.json"))
+		if err == nil {
+			var pkg struct {
+				Version string `json:"version"`
+			}
+			_ = json.Unmarshal(data, &pkg)
+			return pkg.Version
+		}
+		if d == root || d == filepath.Dir(d) {
+			return ""
+		}
+	}
+}
+
+// readNpmLock package-lock.json (v2/v3 "packages", v1 "dependencies") okur
+func readNpmLock(lockPath, rel string) (lockedVersion, error) {
+	data, err := os.ReadFile(lockPath)
+	if err != nil {
+		return nil, err
+	}
+	var lock struct {
+		Packages map[string]struct {
+			Version string `json:"version"`
+			Link    bool   `json:"link"`
+		} `json:"packages"`
+		Dependencies map[string]struct {
+			Version string `json:"version"`
+		} `json:"dependencies"`
+	}
+	if err := json.Unmarshal(data, &lock); err != nil {
+		return nil, err
+	}
+
+	return func(name, _ string) (string, bool) {
+		if len(lock.Packages) == 0 {
+			dep, ok := lock.Dependencies[name]
+			return dep.Version, ok
+		}
+		// Önce üyenin kendi node_modules'u, sonra köke doğru hoist edilmiş kopya
+		for prefix := rel; ; prefix = path.Dir(prefix) {
+			key := "node_modules/" + name
+			if prefix != "." {
+				key = prefix + "/" + key
+			}
+			if e, ok := lock.Packages[key]; ok {
+				if e.Link {
+					return "", true
+				}
+				return e.Version, true
+			}
+			if prefix == "." || prefix == "/" {
+				return "", false
+			}
+		}
+	}, nil
+}
+
+// pnpmDep pnpm-lock.yaml'daki bağımlılıktır. v5'te değer düz sürümdür,
+// v6+ sürümlerde {specifier, version} nesnesidir.
+type pnpmDep struct {
+	Specifier string
+	Version   string
+}
+
+func (d *pnpmDep) UnmarshalYAML(n *yaml.Node) error {
+	if n.Kind == yaml.ScalarNode {
+		d.Version = n.Value
+		return nil
+	}
+	var v struct {
+		Specifier string `yaml:"specifier"`
+		Version   string `yaml:"version"`
+	}
+	if err := n.Decode(&v); err != nil {
+		return err
+	}
+	d.Specifier, d.Version = v.Specifier, v.Version
+	return nil
+}
+
+type pnpmImporter struct {
+	Dependencies         map[string]pnpmDep `yaml:"dependencies"`
+	DevDependencies      map[string]pnpmDep `yaml:"devDependencies"`
+	OptionalDependencies map[string]pnpmDep `yaml:"optionalDependencies"`
+}
+
+// readPnpmLock pnpm-lock.yaml okur; workspace'te (ve v9'da her zaman) bağımlılıklar "importers" altındadır
+func readPnpmLock(lockPath, rel string) (lockedVersion, error) {
+	data, err := os.ReadFile(lockPath)
+	if err != nil {
+		return nil, err
+	}
+	var lock struct {
+		pnpmImporter `yaml:",inline"`
+		Importers    map[string]pnpmImporter `yaml:"importers"`
+	}
+	if err := yaml.Unmarshal(data, &lock); err != nil {
+		return nil, err
+	}
+	importer := lock.pnpmImporter
+	if imp, ok := lock.Importers[rel]; ok {
+		importer = imp
+	}
+
+	return func(name, _ string) (string, bool) {
+		for _, deps := range []map[string]pnpmDep{importer.Dependencies, importer.DevDependencies, importer.OptionalDependencies} {
+			if d, ok := deps[name]; ok {
+				return pnpmVersion(d.Version), true
+			}
+		}
+		return "", false
+	}, nil
+}
+
+// pnpmVersion kilitteki sürümden peer eki ve alias'ı temizler
+// ("18.2.0(react@18.2.0)", "1.0.0_react@18.2.0", "string-width@4.2.3", "/foo/1.0.0" -> sürüm; "link:../ui" -> "")
+func pnpmVersion(v string) string {
+	v, _, _ = strings.Cut(v, "(")
+	if localSpec(v) {
+		return ""
+	}
+	if i := strings.Index(v, "_"); i > 0 {
+		if _, ok := ParseSemver(v[:i]); ok {
+			v = v[:i]
+		}
+	}
+	if strings.HasPrefix(v, "/") {
+		return path.Base(v)
+	}
+	if i := strings.LastIndex(v, "@"); i > 0 {
+		v = v[i+1:]
+	}
+	return v
+}
+
+// readYarnLock Yarn 1 ve Yarn 2+ (berry) yarn.lock dosyalarını okur
+func readYarnLock(lockPath string) (lockedVersion, error) {
+	entries, err := parseYarnLock(lockPath)
+	if err != nil {
+		return nil, err
+	}
+	versions := make(map[string][]string) // paket -> kilitteki sürümler
+	for desc, v := range entries {
+		name := yarnDescriptorName(desc)
+		versions[name] = append(versions[name], v)
+	}
+
+	return func(name, spec string) (string, bool) {
+		if v, ok := entries[name+"@"+spec]; ok {
+			return v, true
+		}
+		if v, ok := entries[name+"@npm:"+spec]; ok {
+			return v, true
+		}
+		// Tanım değişmiş olabilir; kilitteki sürümlerden aralığa uyan varsa o kullanılır
+		vs := versions[name]
+		if len(vs) == 0 {
+			return "", false
+		}
+		var allowed []string
+		for _, v := range vs {
+			if rangeAllows(spec, v) {
+				allowed = append(allowed, v)
+			}
+		}
+		if len(allowed) > 0 {
+			return highestVersion(allowed), true
+		}
+		return highestVersion(vs), true
+	}, nil
+}
+
+// parseYarnLock tanım -> sürüm eşlemesini döndürür.
+// Başlıklar paketi isteyen tanımlardır ("react@^18.0.0", "react@npm:^18.0.0").
+func parseYarnLock(lockPath string) (map[string]string, error) {
+	f, err := os.Open(lockPath)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	entries := make(map[string]string)
+	var current []string
+	sc := bufio.NewScanner(f)
+	sc.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+	for sc.Scan() {
+		line := strings.TrimRight(sc.Text(), "\r")
+		if strings.TrimSpace(line) == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if !strings.HasPrefix(line, " ") {
+			current = nil
+			header := strings.TrimSuffix(strings.TrimSpace(line), ":")
+			for _, desc := range strings.Split(header, ",") {
+				if desc = strings.Trim(strings.TrimSpace(desc), `"`); len(desc) > 1 && strings.Contains(desc[1:], "@") {
+					current = append(current, desc)
+				}
+			}
+			continue
+		}
+		// Sadece girdinin kendi alanları (2 boşluk); iç içe "dependencies" blokları atlanır
+		if current == nil || strings.HasPrefix(line, "   ") {
+			continue
+		}
+		field := strings.TrimSpace(line)
+		if !strings.HasPrefix(field, "version ") && !strings.HasPrefix(field, "version:") {
+			continue
+		}
+		v := strings.Trim(strings.TrimSpace(strings.TrimPrefix(strings.TrimPrefix(field, "version"), ":")), `"`)
+		for _, desc := range current {
+			entries[desc] = v
+		}
+		current = nil
+	}
+	return entries, sc.Err()
+}
+
+// yarnDescriptorName "@scope/pkg@^1.0.0" -> "@scope/pkg"
+func yarnDescriptorName(desc string) string {
+	if i := strings.Index(desc[1:], "@"); i >= 0 {
+		return desc[:i+1]
+	}
+	return desc
+}
+
+// resolvedPackage kilit dosyasında çözümlenmiş tek bir paket sürümüdür (dolaylı bağımlılıklar dahil)
+type resolvedPackage struct {
+	name, version string
+}
+
+// lockedPackages kilit dosyasındaki tüm paket sürümlerini tekrarsız döndürür (güvenlik taraması için)
+func lockedPackages(lockPath string) ([]resolvedPackage, error) {
+	seen := make(map[resolvedPackage]bool)
+	var pkgs []resolvedPackage
+	add := func(name, version string) {
+		if _, ok := ParseSemver(version); !ok || name == "" {
+			return // link, git, tarball
+		}
+		p := resolvedPackage{name, version}
+		if !seen[p] {
+			seen[p] = true
+			pkgs = append(pkgs, p)
+		}
+	}
+
+	switch filepath.Base(lockPath) {
+	case "package-lock.json", "npm-shrinkwrap.json":
+		data, err := os.ReadFile(lockPath)
+		if err != nil {
+			return nil, err
+		}
+		var lock struct {
+			Packages map[string]struct {
+				Name    string `json:"name"`
+				Version string `json:"version"`
+			} `json:"packages"`
+			Dependencies map[string]npmLockV1Dep `json:"dependencies"`
+		}
+		if err := json.Unmarshal(data, &lock); err != nil {
+			return nil, err
+		}
+		for key, e := range lock.Packages {
+			i := strings.LastIndex(key, "node_modules/")
+			if i < 0 {
+				continue // Kök paket veya workspace üyesi
+			}
+			name := key[i+len("node_modules/"):]
+			if e.Name != "" {
+				name = e.Name // Alias ("npm:string-width@4") gerçek paket adını taşır
+			}
+			add(name, e.Version)
+		}
+		// v1: iç içe "dependencies"
+		var walk func(deps map[string]npmLockV1Dep)
+		walk = func(deps map[string]npmLockV1Dep) {
+			for name, d := range deps {
+				add(name, d.Version)
+				walk(d.Dependencies)
+			}
+		}
+		walk(lock.Dependencies)
+
+	case "pnpm-lock.yaml":
+		data, err := os.ReadFile(lockPath)
+		if err != nil {
+			return nil, err
+		}
+		var lock struct {
+			Packages map[string]yaml.Node `yaml:"packages"`
+		}
+		if err := yaml.Unmarshal(data, &lock); err != nil {
+			return nil, err
+		}
+		// v9: "name@1.0.0", v6: "/name@1.0.0(peer@1)", v5: "/name/1.0.0_peer@1"
+		for key := range lock.Packages {
+			key, _, _ = strings.Cut(strings.TrimPrefix(key, "/"), "(")
+			if i := strings.LastIndex(key, "/"); i > 0 {
+				if version, _, _ := strings.Cut(key[i+1:], "_"); isSemver(version) {
+					add(key[:i], version)
+					continue
+				}
+			}
+			if i := strings.LastIndex(key, "@"); i > 0 {
+				add(key[:i], key[i+1:])
+			}
+		}
+
+	case "yarn.lock":
+		entries, err := parseYarnLock(lockPath)
+		if err != nil {
+			return nil, err
+		}
+		for desc, v := range entries {
+			add(yarnDescriptorName(desc), v)
+		}
+
+	default:
+		return nil, fmt.Errorf("%s okunamıyor", filepath.Base(lockPath))
+	}
+
+	sort.Slice(pkgs, func(i, j int) bool {
+		if pkgs[i].name != pkgs[j].name {
+			return pkgs[i].name < pkgs[j].name
+		}
+		return pkgs[i].version < pkgs[j].version
+	})
+	return pkgs, nil
+}
+
+type npmLockV1Dep struct {
+	Version      string                  `json:"version"`
+	Dependencies map[string]npmLockV1Dep `json:"dependencies"`
+}
+
+// --- go.mod / go.sum ---
+
+type goDrift struct{}
+
+func (goDrift) Name() string { return "go" }
+
+func (goDrift) Detect(dir string) bool {
+	return pathExists(filepath.Join(dir, "go.mod"))
+}
+
+func (goDrift) Drift(dir string) ([]domain.LockfileDrift, error) {
+	requires, replaced, err := readGoMod(filepath.Join(dir, "go.mod"))
+	if err != nil {
+		return nil, err
+	}
+	sumPath := filepath.Join(dir, "go.sum")
+	data, err := os.ReadFile(sumPath)
+	if err != nil {
+		if len(requires) == 0 {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("go.sum bulunamadı (go mod tidy çalıştırın)")
+	}
+
+	// "modül sürüm[/go.mod] hash" satırları
+	sums := make(map[string]map[string]bool)
+	for _, line := range strings.Split(string(data), "\n") {
+		f := strings.Fields(line)
+		if len(f) < 3 {
+			continue
+		}
+		if sums[f[0]] == nil {
+			sums[f[0]] = make(map[string]bool)
+		}
+		sums[f[0]][strings.TrimSuffix(f[1], "/go.mod")] = true
+	}
+
+	var drift []domain.LockfileDrift
+	for _, r := range requires {
+		// replace edilen modüller (yerel dizin veya fork) go.sum'da farklı tutulur
+		if replaced[r.path] {
+			continue
+		}
+		d := domain.LockfileDrift{Ecosystem: "go", Name: r.path, Declared: r.version, Lockfile: sumPath, Dir: dir}
+		vs := sums[r.path]
+		if vs[r.version] {
+			continue
+		}
+		if len(vs) == 0 {
+			d.Issue = domain.DriftMissing
+		} else {
+			d.Issue = domain.DriftUnsatisfied
+			locked := make([]string, 0, len(vs))
+			for v := range vs {
+				locked = append(locked, v)
+			}
+			d.Locked = highestVersion(locked)
+		}
+		drift = append(drift, d)
+	}
+	return drift, nil
+}
+
+type goRequire struct {
+	path, version string
+	indirect      bool // "// indirect" yorumu: sadece dolaylı bağımlılık olarak gerekli
+}
+
+// readGoMod go.mod'daki require satırlarını ve replace edilen modülleri okur
+func readGoMod(modPath string) ([]goRequire, map[string]bool, error) {
+	data, err := os.ReadFile(modPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	var requires []goRequire
+	replaced := make(map[string]bool)
+	add := func(directive string, f []string, comment string) {
+		switch {
+		case directive == "require" && len(f) >= 2:
+			indirect := strings.HasPrefix(strings.TrimSpace(comment), "indirect")
+			requires = append(requires, goRequire{path: strings.Trim(f[0], `"`), version: f[1], indirect: indirect})
+		case directive == "replace" && len(f) >= 1:
+			replaced[strings.Trim(f[0], `"`)] = true
+		}
+	}
+
+	block := "" // İçinde bulunulan "require (" / "replace (" bloğu
+	for _, line := range strings.Split(string(data), "\n") {
+		line, comment, _ := strings.Cut(line, "//")
+		f := strings.Fields(line)
+		switch {
+		case len(f) == 0:
+		case block != "" && f[0] == ")":
+			block = ""
+		case block != "":
+			add(block, f, comment)
+		case (f[0] == "require" || f[0] == "replace") && len(f) > 1:
+			if f[1] == "(" {
+				block = f[0]
+			} else {
+				add(f[0], f[1:], comment)
+			}
+		}
+	}
+	return requires, replaced, nil
+}
+
+// goSumModules go.sum'daki modül sürümlerini döndürür. Sadece "/go.mod" özeti olan
+// sürümler derlemeye girmez (modül grafiği için indirilir) ve atlanır.
+func goSumModules(sumPath string) ([]resolvedPackage, error) {
+	f, err := os.Open(sumPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("bulunamadı (go mod tidy çalıştırın)")
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	seen := make(map[resolvedPackage]bool)
+	var mods []resolvedPackage
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		fields := strings.Fields(sc.Text())
+		if len(fields) < 3 || strings.HasSuffix(fields[1], "/go.mod") {
+			continue
+		}
+		m := resolvedPackage{fields[0], fields[1]}
+		if !seen[m] {
+			seen[m] = true
+			mods = append(mods, m)
+		}
+	}
+	return mods, sc.Err()
+}
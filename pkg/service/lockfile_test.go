@@ -0,0 +1,128 @@
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestPnpmVersion(t *testing.T) {
+	tests := map[string]string{
+		"18.2.0":               "18.2.0",
+		"18.2.0(react@18.2.0)": "18.2.0",
+		"7.0.0(@types/react@18.2.0)(react@18.2.0)": "7.0.0",
+		"1.0.0_react@18.2.0":                       "1.0.0",
+		"1.0.0_react@18.2.0+react-dom@18.2.0":      "1.0.0",
+		"string-width@4.2.3":                       "4.2.3",
+		"/foo/1.0.0":                               "1.0.0",
+		"/@scope/foo/2.1.0":                        "2.1.0",
+		"link:../ui":                               "",
+		"file:../local":                            "",
+		"workspace:*":                              "",
+		"1.0.0-beta.1":                             "1.0.0-beta.1",
+	}
+	for in, want := range tests {
+		if got := pnpmVersion(in); got != want {
+			t.Errorf("pnpmVersion(%q) = %q, beklenen %q", in, got, want)
+		}
+	}
+}
+
+func TestParseYarnLock(t *testing.T) {
+	tests := []struct {
+		name string
+		lock string
+		want map[string]string
+	}{
+		{
+			name: "yarn 1",
+			lock: `# THIS IS AN AUTOGENERATED FILE. DO NOT EDIT THIS FILE DIRECTLY.
+# yarn lockfile v1
+
+
+"@babel/code-frame@^7.0.0", "@babel/code-frame@^7.10.4":
+  version "7.12.13"
+  resolved "https://registry.yarnpkg.com/@babel/code-frame/-/code-frame-7.12.13.tgz"
+  dependencies:
+    "@babel/highlight" "^7.12.13"
+
+lodash@^4.17.20:
+  version "4.17.21"
+  resolved "https://registry.yarnpkg.com/lodash/-/lodash-4.17.21.tgz"
+
+string-width-cjs@npm:string-width@^4.2.0:
+  version "4.2.3"
+`,
+			want: map[string]string{
+				"@babel/code-frame@^7.0.0":                 "7.12.13",
+				"@babel/code-frame@^7.10.4":                "7.12.13",
+				"lodash@^4.17.20":                          "4.17.21",
+				"string-width-cjs@npm:string-width@^4.2.0": "4.2.3",
+			},
+		},
+		{
+			name: "yarn berry",
+			lock: `# This file is generated by running "yarn install" inside your project.
+
+__metadata:
+  version: 6
+  cacheKey: 8
+
+"react@npm:^18.0.0, react@npm:^18.2.0":
+  version: 18.2.0
+  resolution: "react@npm:18.2.0"
+  dependencies:
+    loose-envify: ^1.1.0
+  checksum: 88e38092da
+
+"ui@workspace:packages/ui":
+  version: 0.0.0-use.local
+  resolution: "ui@workspace:packages/ui"
+`,
+			want: map[string]string{
+				"react@npm:^18.0.0":        "18.2.0",
+				"react@npm:^18.2.0":        "18.2.0",
+				"ui@workspace:packages/ui": "0.0.0-use.local",
+			},
+		},
+		{
+			name: "CRLF ve iç içe version alanı",
+			lock: "left-pad@^1.0.0:\r\n  dependencies:\r\n    version \"9.9.9\"\r\n  version \"1.3.0\"\r\n",
+			want: map[string]string{"left-pad@^1.0.0": "1.3.0"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "yarn.lock")
+			if err := os.WriteFile(path, []byte(tt.lock), 0o644); err != nil {
+				t.Fatal(err)
+			}
+			got, err := parseYarnLock(path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(got) != len(tt.want) {
+				t.Errorf("%d girdi okundu, beklenen %d: %v", len(got), len(tt.want), got)
+			}
+			for desc, v := range tt.want {
+				if got[desc] != v {
+					t.Errorf("%q = %q, beklenen %q", desc, got[desc], v)
+				}
+			}
+		})
+	}
+}
+
+func TestYarnDescriptorName(t *testing.T) {
+	tests := map[string]string{
+		"react@^18.0.0":        "react",
+		"react@npm:^18.0.0":    "react",
+		"@scope/pkg@^1.0.0":    "@scope/pkg",
+		"@scope/pkg@npm:1.0.0": "@scope/pkg",
+	}
+	for desc, want := range tests {
+		if got := yarnDescriptorName(desc); got != want {
+			t.Errorf("yarnDescriptorName(%q) = %q, beklenen %q", desc, got, want)
+		}
+	}
+}
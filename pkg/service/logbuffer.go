@@ -0,0 +1,142 @@
+package service
+
+import (
+	"bytes"
+	"sync"
+	"unicode/utf8"
+)
+
+// LogBuffer süreç çıktısını satır bazlı, sabit kapasiteli bir halka tamponda tutar
+type LogBuffer struct {
+	mu        sync.Mutex
+	lines     []string
+	system    []bool // Append ile eklenen (sürecin yazmadığı) satırlar
+	start     int    // en eski satırın indeksi
+	size      int
+	partial   bytes.Buffer // henüz '\n' ile bitmemiş satır (yarım kalmış UTF-8 dizileri dahil)
+	pendingCR bool         // son bayt '\r' idi: ardından '\n' gelmezse satır sıfırlanır
+}
+
+// NewLogBuffer en fazla capacity satır tutan bir tampon oluşturur
+func NewLogBuffer(capacity int) *LogBuffer {
+	if capacity <= 0 {
+		capacity = 1000
+	}
+	return &LogBuffer{lines: make([]string, capacity), system: make([]bool, capacity)}
+}
+
+// maxPartialLine '\n' gelmeden biriken satırın üst sınırı; aşılınca satır olarak kaydedilir
+const maxPartialLine = 64 * 1024
+
+// Write io.Writer arayüzünü uygular (stdout/stderr buraya bağlanır)
+func (b *LogBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	// Parçalar bayt olarak biriktirilir, satır ancak '\n' gelince çözülür: iki Write'a
+	// bölünmüş çok baytlı bir karakter (ş, ğ, ı) U+FFFD'ye dönüşmez
+	for _, c := range p {
+		if b.pendingCR {
+			b.pendingCR = false
+			// CRLF değilse '\r' satır başına döner: ilerleme çubuğunun önceki karesi silinir
+			if c != '\n' {
+				b.partial.Reset()
+			}
+		}
+		switch c {
+		case '\n':
+			b.push(b.partial.String(), false)
+			b.partial.Reset()
+		case '\r':
+			b.pendingCR = true
+		default:
+			b.partial.WriteByte(c)
+			if b.partial.Len() >= maxPartialLine {
+				b.flushPartial()
+			}
+		}
+	}
+	return len(p), nil
+}
+
+// flushPartial uzun satırı kaydeder; sondaki yarım UTF-8 dizisi bir sonraki satıra kalır
+func (b *LogBuffer) flushPartial() {
+	data := b.partial.Bytes()
+	cut := len(data)
+	for i := len(data) - 1; i >= 0 && i >= len(data)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(data[i]) {
+			if !utf8.FullRune(data[i:]) {
+				cut = i
+			}
+			break
+		}
+	}
+	rest := append([]byte(nil), data[cut:]...)
+	b.push(string(data[:cut]), false)
+	b.partial.Reset()
+	b.partial.Write(rest)
+}
+
+// Append tampona tek bir satır ekler (sistem mesajları için)
+func (b *LogBuffer) Append(line string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.partial.Len() > 0 {
+		b.push(b.partial.String(), false)
+		b.partial.Reset()
+	}
+	b.pendingCR = false
+	b.push(line, true)
+}
+
+func (b *LogBuffer) push(line string, system bool) {
+	capacity := len(b.lines)
+	i := b.start
+	if b.size < capacity {
+		i = (b.start + b.size) % capacity
+		b.size++
+	} else {
+		b.start = (b.start + 1) % capacity
+	}
+	b.lines[i], b.system[i] = line, system
+}
+
+// Lines tampondaki satırları eskiden yeniye doğru döndürür
+func (b *LogBuffer) Lines() []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	out := make([]string, 0, b.size+1)
+	for i := 0; i < b.size; i++ {
+		out = append(out, b.lines[(b.start+i)%len(b.lines)])
+	}
+	if b.partial.Len() > 0 {
+		out = append(out, b.partial.String())
+	}
+	return out
+}
+
+// OutputLines Lines gibidir ama sadece sürecin kendi yazdığı satırları döndürür
+// (Append ile eklenen "▶ komut" gibi sistem mesajları hariç)
+func (b *LogBuffer) OutputLines() []string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	out := make([]string, 0, b.size+1)
+	for i := 0; i < b.size; i++ {
+		if j := (b.start + i) % len(b.lines); !b.system[j] {
+			out = append(out, b.lines[j])
+		}
+	}
+	if b.partial.Len() > 0 {
+		out = append(out, b.partial.String())
+	}
+	return out
+}
+
+// Len tampondaki tamamlanmış satır sayısını döndürür
+func (b *LogBuffer) Len() int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.size
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestLogBufferWrite(t *testing.T) {
+	tests := []struct {
+		name   string
+		chunks []string
+		want   []string
+	}{
+		{"satırlar", []string{"a\nb\n", "c"}, []string{"a", "b", "c"}},
+		{"CRLF", []string{"a\r\nb\r", "\n"}, []string{"a", "b"}},
+		{"ilerleme çubuğu", []string{"%10\r%50\r", "%100\ndone\n"}, []string{"%100", "done"}},
+		{"son kare görünür", []string{"%10\r%50\r"}, []string{"%50"}},
+		{"bölünmüş UTF-8", []string{"\xc5", "\x9f\xc4", "\x9f\xc4\xb1\n"}, []string{"şğı"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := NewLogBuffer(10)
+			for _, c := range tt.chunks {
+				b.Write([]byte(c))
+			}
+			if got := b.Lines(); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Lines() = %q, beklenen %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLogBufferLongLine(t *testing.T) {
+	b := NewLogBuffer(10)
+	// Sınırda ikiye bölünen "ş" bir sonraki satıra kalmalı
+	long := strings.Repeat("a", maxPartialLine-1) + "ş" + "b"
+	b.Write([]byte(long))
+	lines := b.Lines()
+	if len(lines) != 2 {
+		t.Fatalf("%d satır, beklenen 2", len(lines))
+	}
+	for _, l := range lines {
+		if !utf8.ValidString(l) {
+			t.Errorf("geçersiz UTF-8: %q", l[len(l)-4:])
+		}
+	}
+	if lines[1] != "şb" {
+		t.Errorf("ikinci satır %q, beklenen %q", lines[1], "şb")
+	}
+}
+
+func TestLogBufferOutputLines(t *testing.T) {
+	b := NewLogBuffer(3)
+	b.Append("▶ npm run dev")
+	b.Write([]byte("ready on 3000\n"))
+	b.Append("sistem")
+	b.Write([]byte("x\n"))
+	if got, want := b.OutputLines(), []string{"ready on 3000", "x"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("OutputLines() = %q, beklenen %q", got, want)
+	}
+	// Halka dolunca en eski satır düşer
+	if got, want := b.Lines(), []string{"ready on 3000", "sistem", "x"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Lines() = %q, beklenen %q", got, want)
+	}
+}
@@ -0,0 +1,169 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"devterminal/pkg/domain"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ManifestFileName proje köküne konulan servis manifestinin adı
+const ManifestFileName = ".devterminal.yaml"
+
+// projectManifest .devterminal.yaml dosyasının yapısı
+//
+//	services:
+//	  api:
+//	    command: go run ./cmd/api
+//	    dir: backend
+//	    ports: [8080]
+//	    ready: { tcp: "8080" }
+//	  web:
+//	    command: npm run dev
+//	    dir: web
+//	    depends_on: [api]
+type projectManifest struct {
+	Services map[string]domain.Service `yaml:"services"`
+}
+
+// LoadManifest proje kökündeki manifesti okur; dosya yoksa nil döner.
+// Servisler isme göre sıralı döner.
+func LoadManifest(projectPath string) ([]domain.Service, error) {
+	data, err := os.ReadFile(filepath.Join(projectPath, ManifestFileName))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var m projectManifest
+	if err := yaml.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("%s okunamadı: %w", ManifestFileName, err)
+	}
+
+	services := make([]domain.Service, 0, len(m.Services))
+	for name, svc := range m.Services {
+		svc.Name = name
+		if strings.TrimSpace(svc.Command) == "" {
+			return nil, fmt.Errorf("%s: '%s' servisi için command tanımlı değil", ManifestFileName, name)
+		}
+		for _, dep := range svc.DependsOn {
+			if _, ok := m.Services[dep]; !ok {
+				return nil, fmt.Errorf("%s: '%s' servisi bilinmeyen '%s' servisine bağlı", ManifestFileName, name, dep)
+			}
+		}
+		services = append(services, svc)
+	}
+	sort.Slice(services, func(i, j int) bool { return services[i].Name < services[j].Name })
+
+	if _, err := ServiceOrder(services, nil); err != nil {
+		return nil, err
+	}
+	return services, nil
+}
+
+// ServiceOrder istenen servisleri bağımlılıklarıyla birlikte başlatma sırasına dizer.
+// names boşsa tüm servisler dahil edilir. Döngüsel bağımlılıkta hata döner.
+func ServiceOrder(services []domain.Service, names []string) ([]domain.Service, error) {
+	byName := make(map[string]domain.Service, len(services))
+	for _, svc := range services {
+		byName[svc.Name] = svc
+	}
+	if len(names) == 0 {
+		for _, svc := range services {
+			names = append(names, svc.Name)
+		}
+	}
+
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int)
+	var order []domain.Service
+
+	// Derinlik öncelikli gezinti: bağımlılıklar her zaman bağımlılardan önce eklenir
+	var visit func(name string, path []string) error
+	visit = func(name string, path []string) error {
+		svc, ok := byName[name]
+		if !ok {
+			return fmt.Errorf("bilinmeyen servis: %s", name)
+		}
+		switch state[name] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("döngüsel bağımlılık: %s → %s", strings.Join(path, " → "), name)
+		}
+		state[name] = visiting
+		next := append(append([]string(nil), path...), name)
+		deps := append([]string(nil), svc.DependsOn...)
+		sort.Strings(deps)
+		for _, dep := range deps {
+			if err := visit(dep, next); err != nil {
+				return err
+			}
+		}
+		state[name] = visited
+		order = append(order, svc)
+		return nil
+	}
+
+	for _, name := range names {
+		if err := visit(name, nil); err != nil {
+			return nil, err
+		}
+	}
+	return order, nil
+}
+
+// ServicePlan seçilen servislerin (bağımlılıklarıyla) başlatma planı
+func (l *Launcher) ServicePlan(p *domain.Project, names []string) (LaunchPlan, error) {
+	steps, err := l.ServiceSteps(p, names)
+	return LaunchPlan{Kind: domain.LaunchServices, Targets: names, Steps: steps}, err
+}
+
+// ServiceSteps seçilen servisleri (ve bağımlılıklarını) sıralı başlatma adımlarına çevirir
+func (l *Launcher) ServiceSteps(p *domain.Project, names []string) ([]LaunchStep, error) {
+	order, err := ServiceOrder(p.Services, names)
+	if err != nil {
+		return nil, err
+	}
+
+	steps := make([]LaunchStep, 0, len(order))
+	for _, svc := range order {
+		dir := p.Path
+		if svc.Dir != "" {
+			dir = filepath.Join(p.Path, svc.Dir)
+		}
+		steps = append(steps, LaunchStep{
+			Name: p.Name + " › " + svc.Name,
+			Request: TerminalRequest{
+				Title:   p.Name + " › " + svc.Name,
+				Dir:     dir,
+				Command: svc.Command,
+				Env:     serviceEnv(svc.Env),
+			},
+			Ready: svc.Ready,
+		})
+	}
+	return steps, nil
+}
+
+// serviceEnv ortam değişkenlerini KEY=VALUE listesine çevirir (sıralı, deterministik)
+func serviceEnv(env map[string]string) []string {
+	out := make([]string, 0, len(env))
+	for k, v := range env {
+		out = append(out, k+"="+v)
+	}
+	sort.Strings(out)
+	return out
+}
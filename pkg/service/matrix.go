@@ -0,0 +1,314 @@
+package service
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"devterminal/pkg/domain"
+)
+
+// MatrixCell bir projenin bağımlılık için kullandığı sürümdür
+type MatrixCell struct {
+	Project  string `json:"project"` // Proje adı; aynı adlı projelerde üst klasörle ayrıştırılır (bkz. matrixLabels)
+	Path     string `json:"path"`
+	Version  string `json:"version"`  // Kilit dosyasındaki sürüm; kilit yoksa manifestteki aralık
+	Declared string `json:"declared"` // Manifestteki aralık (go.mod'da gereken sürüm)
+	Locked   bool   `json:"locked"`   // Sürüm kilit dosyasından (Go için go.sum'dan) doğrulandı mı
+	Dev      bool   `json:"dev,omitempty"`
+	Behind   bool   `json:"behind,omitempty"` // Projeler arasındaki en yüksek sürümün major gerisinde
+}
+
+// MatrixRow tek bir bağımlılığın projelerdeki sürümleridir
+type MatrixRow struct {
+	Ecosystem string       `json:"ecosystem"` // npm (tüm JS paket yöneticileri) veya go
+	Name      string       `json:"name"`
+	Highest   string       `json:"highest"`
+	Divergent bool         `json:"divergent"` // Projeler farklı sürümler kullanıyor
+	Cells     []MatrixCell `json:"projects"`  // Proje adına göre sıralı; bağımlılığı kullanmayan projeler yok
+}
+
+// VersionCount bir sürümü kullanan proje sayısıdır
+type VersionCount struct {
+	Version  string
+	Projects int
+}
+
+// Versions kullanılan farklı sürümleri en yüksekten başlayarak döndürür
+func (r MatrixRow) Versions() []VersionCount {
+	var versions []VersionCount
+	index := make(map[string]int)
+	for _, c := range r.Cells {
+		i, ok := index[c.Version]
+		if !ok {
+			i = len(versions)
+			index[c.Version] = i
+			versions = append(versions, VersionCount{Version: c.Version})
+		}
+		versions[i].Projects++
+	}
+	sort.SliceStable(versions, func(i, j int) bool {
+		a, aok := comparableVersion(versions[i].Version)
+		b, bok := comparableVersion(versions[j].Version)
+		if aok && bok {
+			return a.Compare(b) > 0
+		}
+		return aok && !bok
+	})
+	return versions
+}
+
+// DependencyMatrix taranan projelerin bağımlılıklarını karşılaştırır
+type DependencyMatrix struct {
+	Projects []string    `json:"projects"`
+	Rows     []MatrixRow `json:"dependencies"`
+	Errors   []string    `json:"errors,omitempty"` // Okunamayan manifest / kilit dosyaları
+}
+
+// BuildMatrix projelerin kök, frontend, backend ve alt proje klasörlerindeki package.json ve go.mod
+// dosyalarını okur. Ağa çıkılmaz; sürümler kilit dosyasından (yoksa manifestten) alınır.
+func BuildMatrix(projects []domain.Project) *DependencyMatrix {
+	mx := &DependencyMatrix{}
+	rows := make(map[string]*MatrixRow)
+	add := func(eco, name string, cell MatrixCell) {
+		key := eco + "\x00" + name
+		row, ok := rows[key]
+		if !ok {
+			row = &MatrixRow{Ecosystem: eco, Name: name}
+			rows[key] = row
+		}
+		// Aynı projenin birden fazla klasöründe varsa ilk bulunan (kök) kullanılır
+		for _, c := range row.Cells {
+			if c.Path == cell.Path {
+				return
+			}
+		}
+		row.Cells = append(row.Cells, cell)
+	}
+
+	labels := matrixLabels(projects)
+	for i := range projects {
+		p := &projects[i]
+		label, ok := labels[p.Path]
+		if !ok {
+			continue // Aynı proje iki kez listelenmiş
+		}
+		delete(labels, p.Path)
+		mx.Projects = append(mx.Projects, label)
+		for _, dir := range projectDirs(p) {
+			if pathExists(filepath.Join(dir, "package.json")) {
+				if err := matrixPackageJSON(p, label, dir, add); err != nil {
+					mx.Errors = append(mx.Errors, label+": "+err.Error())
+				}
+			}
+			if pathExists(filepath.Join(dir, "go.mod")) {
+				requires, _, err := readGoMod(filepath.Join(dir, "go.mod"))
+				if err != nil {
+					mx.Errors = append(mx.Errors, label+": "+err.Error())
+					continue
+				}
+				// go.mod'daki sürüm go.sum'da yoksa (go mod tidy çalıştırılmamış) kilitli sayılmaz
+				sums := make(map[resolvedPackage]bool)
+				mods, _ := goSumModules(filepath.Join(dir, "go.sum"))
+				for _, m := range mods {
+					sums[m] = true
+				}
+				for _, r := range requires {
+					add("go", r.path, MatrixCell{Project: label, Path: p.Path, Version: r.version, Declared: r.version, Locked: sums[resolvedPackage{r.path, r.version}]})
+				}
+			}
+		}
+	}
+	sort.Strings(mx.Projects)
+
+	for _, row := range rows {
+		var highest Semver
+		found := false
+		for _, c := range row.Cells {
+			if v, ok := comparableVersion(c.Version); ok && (!found || v.Compare(highest) > 0) {
+				highest, found, row.Highest = v, true, c.Version
+			}
+		}
+		for i, c := range row.Cells {
+			if c.Version != row.Cells[0].Version {
+				row.Divergent = true
+			}
+			if v, ok := comparableVersion(c.Version); ok && found {
+				row.Cells[i].Behind = ClassifyUpdate(v.String(), highest.String()) == domain.UpdateMajor
+			}
+		}
+		sort.Slice(row.Cells, func(i, j int) bool { return row.Cells[i].Project < row.Cells[j].Project })
+		mx.Rows = append(mx.Rows, *row)
+	}
+	// En çok projede kullanılanlar önce
+	sort.Slice(mx.Rows, func(i, j int) bool {
+		a, b := mx.Rows[i], mx.Rows[j]
+		if len(a.Cells) != len(b.Cells) {
+			return len(a.Cells) > len(b.Cells)
+		}
+		if a.Ecosystem != b.Ecosystem {
+			return a.Ecosystem > b.Ecosystem // npm, sonra go
+		}
+		return a.Name < b.Name
+	})
+	return mx
+}
+
+// matrixLabels projelerin matris kolon adlarını döndürür (yola göre). Farklı projects_paths
+// altındaki aynı adlı projeler üst klasörle ("api (work)"), o da yetmezse tam yolla ayrıştırılır.
+func matrixLabels(projects []domain.Project) map[string]string {
+	count := func(label func(p *domain.Project) string) map[string]int {
+		n := make(map[string]int)
+		seen := make(map[string]bool)
+		for i := range projects {
+			if p := &projects[i]; !seen[p.Path] {
+				seen[p.Path] = true
+				n[label(p)]++
+			}
+		}
+		return n
+	}
+	name := func(p *domain.Project) string { return p.Name }
+	parent := func(p *domain.Project) string {
+		return p.Name + " (" + filepath.Base(filepath.Dir(p.Path)) + ")"
+	}
+	names, parents := count(name), count(parent)
+
+	labels := make(map[string]string, len(projects))
+	for i := range projects {
+		p := &projects[i]
+		switch {
+		case names[p.Name] == 1:
+			labels[p.Path] = p.Name
+		case parents[parent(p)] == 1:
+			labels[p.Path] = parent(p)
+		default:
+			labels[p.Path] = p.Name + " (" + p.Path + ")"
+		}
+	}
+	return labels
+}
+
+// comparableVersion sürümü, kilit dosyası yoksa aralığın alt sınırını karşılaştırılabilir hale getirir ("^18.2" -> 18.2.0)
+func comparableVersion(v string) (Semver, bool) {
+	if s, ok := ParseSemver(v); ok {
+		return s, true
+	}
+	p, err := parsePartial(strings.TrimLeft(npmRangeSpec(v), "^~>=<"))
+	if err != nil || len(p.parts) == 0 {
+		return Semver{}, false
+	}
+	return p.floor(), true
+}
+
+// matrixPackageJSON package.json bağımlılıklarını kilit dosyasındaki sürümleriyle ekler
+func matrixPackageJSON(p *domain.Project, label, dir string, add func(eco, name string, cell MatrixCell)) error {
+	data, err := os.ReadFile(filepath.Join(dir, "package.json"))
+	if err != nil {
+		return err
+	}
+	var pkg packageJSON
+	if err := json.Unmarshal(data, &pkg); err != nil {
+		return err
+	}
+	// Kilit dosyası yoksa manifestteki aralık gösterilir
+	_, lookup, lockErr := jsLockLookup(dir)
+
+	for _, deps := range []struct {
+		m   map[string]string
+		dev bool
+	}{{pkg.Dependencies, false}, {pkg.DevDependencies, true}} {
+		for name, spec := range deps.m {
+			if localSpec(spec) {
+				continue
+			}
+			cell := MatrixCell{Project: label, Path: p.Path, Version: spec, Declared: spec, Dev: deps.dev}
+			if lockErr == nil {
+				if v, found := lookup(name, spec); found && v != "" {
+					cell.Version, cell.Locked = v, true
+				}
+			}
+			add("npm", name, cell)
+		}
+	}
+	return nil
+}
+
+// Filter paket adında query geçen satırları döndürür (büyük/küçük harf duyarsız)
+func (mx *DependencyMatrix) Filter(query string, divergentOnly bool) []MatrixRow {
+	query = strings.ToLower(strings.TrimSpace(query))
+	var rows []MatrixRow
+	for _, r := range mx.Rows {
+		if divergentOnly && !r.Divergent {
+			continue
+		}
+		if query != "" && !strings.Contains(strings.ToLower(r.Name), query) {
+			continue
+		}
+		rows = append(rows, r)
+	}
+	return rows
+}
+
+// WriteCSV matrisi her projenin bir kolon olduğu tablo olarak yazar
+func (mx *DependencyMatrix) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	header := append([]string{"ecosystem", "package", "divergent", "highest"}, mx.Projects...)
+	if err := cw.Write(header); err != nil {
+		return err
+	}
+	for _, r := range mx.Rows {
+		byProject := make(map[string]string, len(r.Cells))
+		for _, c := range r.Cells {
+			byProject[c.Project] = c.Version
+		}
+		record := []string{r.Ecosystem, r.Name, strconv.FormatBool(r.Divergent), r.Highest}
+		for _, p := range mx.Projects {
+			record = append(record, byProject[p])
+		}
+		if err := cw.Write(record); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// WriteJSON matrisi girintili JSON olarak yazar
+func (mx *DependencyMatrix) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(mx)
+}
+
+// ExportMatrix matrisi klasöre dependency-matrix.csv ve dependency-matrix.json olarak kaydeder
+func ExportMatrix(mx *DependencyMatrix, dir string) ([]string, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	var paths []string
+	for _, f := range []struct {
+		name  string
+		write func(io.Writer) error
+	}{{"dependency-matrix.csv", mx.WriteCSV}, {"dependency-matrix.json", mx.WriteJSON}} {
+		path := filepath.Join(dir, f.name)
+		file, err := os.Create(path)
+		if err != nil {
+			return paths, err
+		}
+		err = f.write(file)
+		if cerr := file.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			return paths, err
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
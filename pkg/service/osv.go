@@ -0,0 +1,263 @@
+package service
+
+import (
+	"archive/zip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"devterminal/pkg/domain"
+)
+
+// osvEntry OSV şemasındaki (https://ossf.github.io/osv-schema/) bir advisory'nin kullanılan alanlarıdır
+type osvEntry struct {
+	ID               string   `json:"id"`
+	Aliases          []string `json:"aliases"`
+	Summary          string   `json:"summary"`
+	Withdrawn        string   `json:"withdrawn"`
+	DatabaseSpecific struct {
+		Severity string `json:"severity"` // GitHub advisory'lerinde: CRITICAL, HIGH, MODERATE, LOW
+	} `json:"database_specific"`
+	Affected []struct {
+		Package struct {
+			Ecosystem string `json:"ecosystem"`
+			Name      string `json:"name"`
+		} `json:"package"`
+		Ranges []struct {
+			Type   string              `json:"type"` // SEMVER, ECOSYSTEM, GIT
+			Events []map[string]string `json:"events"`
+		} `json:"ranges"`
+		Versions         []string `json:"versions"`
+		DatabaseSpecific struct {
+			Severity string `json:"severity"`
+		} `json:"database_specific"`
+	} `json:"affected"`
+	References []struct {
+		Type string `json:"type"`
+		URL  string `json:"url"`
+	} `json:"references"`
+}
+
+// OSVDatabase yerel OSV dökümündeki advisory'lerin paket bazlı indeksidir.
+// Döküm https://osv-vulnerabilities.storage.googleapis.com/<ekosistem>/all.zip
+// dosyalarından veya açılmış .json dosyalarından oluşabilir.
+type OSVDatabase struct {
+	Path    string
+	Entries int
+	byPkg   map[string][]*osvEntry // "npm/lodash", "go/golang.org/x/net"
+}
+
+// osvKey ekosistem adını OSV'deki karşılığıyla eşleştirilebilir hale getirir
+func osvKey(ecosystem, name string) string {
+	return strings.ToLower(ecosystem) + "/" + name
+}
+
+// LoadOSV klasördeki tüm .json ve .zip dosyalarını okur
+func LoadOSV(root string) (*OSVDatabase, error) {
+	if !pathExists(root) {
+		return nil, fmt.Errorf("OSV veritabanı bulunamadı: %s", root)
+	}
+	db := &OSVDatabase{Path: root, byPkg: make(map[string][]*osvEntry)}
+	err := filepath.WalkDir(root, func(path string, d os.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return nil
+		}
+		switch strings.ToLower(filepath.Ext(path)) {
+		case ".json":
+			f, err := os.Open(path)
+			if err != nil {
+				return nil
+			}
+			defer f.Close()
+			db.add(f)
+		case ".zip":
+			zr, err := zip.OpenReader(path)
+			if err != nil {
+				return fmt.Errorf("%s açılamadı: %v", filepath.Base(path), err)
+			}
+			defer zr.Close()
+			for _, zf := range zr.File {
+				if !strings.HasSuffix(zf.Name, ".json") {
+					continue
+				}
+				rc, err := zf.Open()
+				if err != nil {
+					continue
+				}
+				db.add(rc)
+				rc.Close()
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if db.Entries == 0 {
+		return nil, fmt.Errorf("OSV veritabanı boş: %s", root)
+	}
+	return db, nil
+}
+
+// add tek bir advisory'yi indekse ekler; okunamayan veya geri çekilmiş kayıtlar atlanır
+func (db *OSVDatabase) add(r io.Reader) {
+	var e osvEntry
+	if json.NewDecoder(r).Decode(&e) != nil || e.ID == "" || e.Withdrawn != "" {
+		return
+	}
+	db.Entries++
+	seen := make(map[string]bool)
+	for _, a := range e.Affected {
+		key := osvKey(a.Package.Ecosystem, a.Package.Name)
+		if !seen[key] {
+			seen[key] = true
+			db.byPkg[key] = append(db.byPkg[key], &e)
+		}
+	}
+}
+
+// Match paketin verilen sürümünü etkileyen advisory'leri döndürür
+func (db *OSVDatabase) Match(ecosystem, name, version string) []domain.Vulnerability {
+	v, ok := ParseSemver(version)
+	if !ok {
+		return nil
+	}
+	var vulns []domain.Vulnerability
+	for _, e := range db.byPkg[osvKey(ecosystem, name)] {
+		for _, a := range e.Affected {
+			if !strings.EqualFold(a.Package.Ecosystem, ecosystem) || a.Package.Name != name {
+				continue
+			}
+			rng, fixed, hit := "", "", containsName(a.Versions, version) || containsName(a.Versions, strings.TrimPrefix(version, "v"))
+			for _, r := range a.Ranges {
+				if r.Type == "GIT" {
+					continue
+				}
+				if ok, rr, f := osvRangeAffects(r.Events, v); ok {
+					hit, rng, fixed = true, rr, f
+					break
+				}
+			}
+			if !hit {
+				continue
+			}
+			sev := a.DatabaseSpecific.Severity
+			if sev == "" {
+				sev = e.DatabaseSpecific.Severity
+			}
+			vulns = append(vulns, domain.Vulnerability{
+				Name: name, Version: version, ID: e.ID, Aliases: e.Aliases, Title: e.Summary,
+				Severity: osvSeverity(e.ID, sev), Range: rng, Fixed: fixed, URL: osvURL(e), Source: "osv",
+			})
+			break
+		}
+	}
+	return vulns
+}
+
+// osvRangeAffects OSV olaylarını sürüm sırasına göre uygular.
+// Sürümü içeren aralığı (örn: ">=1.0.0 <1.2.3") ve varsa düzeltme sürümünü de döndürür.
+func osvRangeAffects(events []map[string]string, v Semver) (bool, string, string) {
+	type event struct {
+		kind string
+		raw  string
+		v    Semver
+	}
+	var evs []event
+	for _, m := range events {
+		for kind, raw := range m {
+			if kind == "limit" {
+				continue
+			}
+			ev := event{kind: kind, raw: raw}
+			if raw != "0" {
+				sv, ok := ParseSemver(raw)
+				if !ok {
+					continue
+				}
+				ev.v = sv
+			}
+			evs = append(evs, ev)
+		}
+	}
+	sort.SliceStable(evs, func(i, j int) bool {
+		if evs[i].raw == "0" || evs[j].raw == "0" {
+			return evs[i].raw == "0" && evs[j].raw != "0"
+		}
+		return evs[i].v.Compare(evs[j].v) < 0
+	})
+
+	// Sürüme kadar olan olayları uygula; sürümden büyük ilk olay aralığın üst sınırıdır
+	affected, introduced, upper, fixed := false, "", "", ""
+	for _, ev := range evs {
+		if ev.raw != "0" && ev.v.Compare(v) > 0 {
+			if affected {
+				switch ev.kind {
+				case "fixed":
+					upper, fixed = "<"+ev.raw, ev.raw
+				case "last_affected":
+					upper = "<=" + ev.raw
+				}
+			}
+			break
+		}
+		switch ev.kind {
+		case "introduced":
+			affected, introduced, upper = true, ev.raw, ""
+		case "fixed":
+			affected = false
+		case "last_affected":
+			// Sürüm son etkilenen sürümün kendisiyse hâlâ etkilenir
+			if ev.v.Compare(v) < 0 {
+				affected = false
+			} else {
+				upper = "<=" + ev.raw
+			}
+		}
+	}
+	if !affected {
+		return false, "", ""
+	}
+
+	rng := upper
+	if introduced != "0" && introduced != "" {
+		rng = strings.TrimSpace(">=" + introduced + " " + upper)
+	}
+	if rng == "" {
+		rng = "*"
+	}
+	return true, rng, fixed
+}
+
+// osvSeverity GitHub / OSV önem derecesini ortak modele çevirir
+func osvSeverity(id, s string) domain.Severity {
+	switch strings.ToLower(s) {
+	case "critical":
+		return domain.SeverityCritical
+	case "high":
+		return domain.SeverityHigh
+	case "moderate", "medium":
+		return domain.SeverityModerate
+	case "low":
+		return domain.SeverityLow
+	}
+	// Zararlı paket kayıtlarında önem derecesi yoktur
+	if strings.HasPrefix(id, "MAL-") {
+		return domain.SeverityCritical
+	}
+	return domain.SeverityUnknown
+}
+
+// osvURL advisory bağlantısını, yoksa osv.dev sayfasını döndürür
+func osvURL(e *osvEntry) string {
+	for _, r := range e.References {
+		if r.Type == "ADVISORY" {
+			return r.URL
+		}
+	}
+	return "https://osv.dev/vulnerability/" + e.ID
+}
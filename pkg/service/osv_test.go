@@ -0,0 +1,55 @@
+package service
+
+import "testing"
+
+func TestOSVRangeAffects(t *testing.T) {
+	type ev = map[string]string
+	tests := []struct {
+		name     string
+		events   []map[string]string
+		version  string
+		affected bool
+		rng      string
+		fixed    string
+	}{
+		{"başlangıçtan düzeltmeye", []map[string]string{ev{"introduced": "0"}, ev{"fixed": "4.17.21"}}, "4.17.20", true, "<4.17.21", "4.17.21"},
+		{"düzeltilmiş sürüm", []map[string]string{ev{"introduced": "0"}, ev{"fixed": "4.17.21"}}, "4.17.21", false, "", ""},
+		{"introduced öncesi", []map[string]string{ev{"introduced": "1.0.0"}, ev{"fixed": "1.2.3"}}, "0.9.0", false, "", ""},
+		{"introduced sürümünün kendisi", []map[string]string{ev{"introduced": "1.0.0"}, ev{"fixed": "1.2.3"}}, "1.0.0", true, ">=1.0.0 <1.2.3", "1.2.3"},
+		{"düzeltme yok", []map[string]string{ev{"introduced": "2.0.0"}}, "3.5.0", true, ">=2.0.0", ""},
+		{"sadece introduced 0", []map[string]string{ev{"introduced": "0"}}, "1.0.0", true, "*", ""},
+		{"last_affected dahil", []map[string]string{ev{"introduced": "1.0.0"}, ev{"last_affected": "1.4.0"}}, "1.4.0", true, ">=1.0.0 <=1.4.0", ""},
+		{"last_affected sonrası", []map[string]string{ev{"introduced": "1.0.0"}, ev{"last_affected": "1.4.0"}}, "1.4.1", false, "", ""},
+		{
+			"birden fazla aralık, ikincisinde",
+			[]map[string]string{ev{"introduced": "1.0.0"}, ev{"fixed": "1.2.0"}, ev{"introduced": "2.0.0"}, ev{"fixed": "2.3.1"}},
+			"2.1.0", true, ">=2.0.0 <2.3.1", "2.3.1",
+		},
+		{
+			"birden fazla aralık, arada",
+			[]map[string]string{ev{"introduced": "1.0.0"}, ev{"fixed": "1.2.0"}, ev{"introduced": "2.0.0"}, ev{"fixed": "2.3.1"}},
+			"1.5.0", false, "", "",
+		},
+		{
+			"sırasız olaylar",
+			[]map[string]string{ev{"fixed": "2.3.1"}, ev{"introduced": "2.0.0"}, ev{"fixed": "1.2.0"}, ev{"introduced": "1.0.0"}},
+			"1.1.0", true, ">=1.0.0 <1.2.0", "1.2.0",
+		},
+		{"limit yok sayılır", []map[string]string{ev{"introduced": "0"}, ev{"limit": "1.0.0"}}, "5.0.0", true, "*", ""},
+		{"semver olmayan olay atlanır", []map[string]string{ev{"introduced": "0"}, ev{"fixed": "abc123"}}, "1.0.0", true, "*", ""},
+		{"ön sürüm düzeltmeden önce", []map[string]string{ev{"introduced": "0"}, ev{"fixed": "2.0.0"}}, "2.0.0-rc.1", true, "<2.0.0", "2.0.0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			v, ok := ParseSemver(tt.version)
+			if !ok {
+				t.Fatalf("ParseSemver(%q) başarısız", tt.version)
+			}
+			affected, rng, fixed := osvRangeAffects(tt.events, v)
+			if affected != tt.affected || rng != tt.rng || fixed != tt.fixed {
+				t.Errorf("osvRangeAffects(%s) = (%v, %q, %q), beklenen (%v, %q, %q)",
+					tt.version, affected, rng, fixed, tt.affected, tt.rng, tt.fixed)
+			}
+		})
+	}
+}
@@ -0,0 +1,489 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"regexp"
+	"runtime"
+	"strings"
+
+	"devterminal/pkg/domain"
+
+	"github.com/pelletier/go-toml/v2"
+)
+
+// OutdatedSource bir ekosistemin eski bağımlılıklarını listeler (npm, go, pip...)
+type OutdatedSource interface {
+	// Name tabloda gösterilen ekosistem adı
+	Name() string
+	// Detect klasörde bu ekosistemin manifesti var mı
+	Detect(dir string) bool
+	// Outdated ekosistemin kendi aracını çalıştırıp sonucu ortak modele çevirir
+	Outdated(dir string) ([]domain.OutdatedDependency, error)
+}
+
+// OutdatedSources desteklenen kaynakları tabloda görünecekleri sırayla döndürür
+func OutdatedSources() []OutdatedSource {
+	return []OutdatedSource{
+		jsOutdated{},
+		goOutdated{},
+		pipOutdated{},
+		composerOutdated{},
+		cargoOutdated{},
+	}
+}
+
+// runCheckCmd komutu çalıştırır; okCodes'taki çıkış kodları (örn: npm'de 1 = eski paket var) hata sayılmaz
+func runCheckCmd(dir string, argv []string, okCodes ...int) ([]byte, error) {
+	out, _, err := runCheckCmdCode(dir, argv, okCodes...)
+	return out, err
+}
+
+// runCheckCmdCode runCheckCmd gibidir, ayrıca kabul edilen çıkış kodunu döndürür
+func runCheckCmdCode(dir string, argv []string, okCodes ...int) ([]byte, int, error) {
+	if _, err := exec.LookPath(argv[0]); err != nil {
+		return nil, 0, fmt.Errorf("%s bulunamadı", argv[0])
+	}
+	cmd := exec.Command(argv[0], argv[1:]...)
+	cmd.Dir = dir
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		if exitErr, ok := err.(*exec.ExitError); ok {
+			for _, c := range okCodes {
+				if exitErr.ExitCode() == c {
+					return out, c, nil
+				}
+			}
+			msg := strings.TrimSpace(stderr.String())
+			if msg == "" {
+				msg = strings.TrimSpace(string(out))
+			}
+			return nil, exitErr.ExitCode(), fmt.Errorf("%s başarısız (exit %d): %s", strings.Join(argv, " "), exitErr.ExitCode(), firstLine(msg))
+		}
+		return nil, 0, fmt.Errorf("%s çalıştırılamadı: %v", argv[0], err)
+	}
+	return out, 0, nil
+}
+
+// firstLine çok satırlı hata çıktısının ilk satırını döndürür
+func firstLine(s string) string {
+	line, _, _ := strings.Cut(s, "\n")
+	return strings.TrimSpace(line)
+}
+
+// --- package.json (npm, pnpm, yarn, bun) ---
+
+type jsOutdated struct{}
+
+func (jsOutdated) Name() string { return "npm" }
+
+func (jsOutdated) Detect(dir string) bool {
+	return pathExists(filepath.Join(dir, "package.json"))
+}
+
+func (jsOutdated) Outdated(dir string) ([]domain.OutdatedDependency, error) {
+	pm := ResolvePackageManager(dir)
+	argv := pm.Outdated()
+	if argv == nil {
+		return nil, fmt.Errorf("%s için outdated komutu yok (yarn upgrade-interactive kullanın)", pm)
+	}
+	if pm.Name == "npm" {
+		argv = append(argv, "--long") // "type" alanı için
+	}
+	out, err := runCheckCmd(dir, argv, 1)
+	if err != nil {
+		return nil, err
+	}
+	if len(bytes.TrimSpace(out)) == 0 {
+		return nil, nil
+	}
+
+	var deps []domain.OutdatedDependency
+	switch pm.Name {
+	case "yarn":
+		deps = parseYarnOutdated(out)
+	case "bun":
+		deps = parseBunOutdated(out)
+	default:
+		// npm ve pnpm aynı JSON biçimini kullanır
+		if deps, err = parseNpmOutdated(out); err != nil {
+			return nil, fmt.Errorf("%s çıktısı parse edilemedi: %v", pm.Name, err)
+		}
+	}
+	for i := range deps {
+		deps[i].Ecosystem, deps[i].Dir, deps[i].Direct = pm.Name, dir, true
+	}
+	return deps, nil
+}
+
+// parseNpmOutdated npm (--long) ve pnpm (--format json) çıktısını okur
+func parseNpmOutdated(output []byte) ([]domain.OutdatedDependency, error) {
+	var res map[string]struct {
+		Current        string `json:"current"`
+		Wanted         string `json:"wanted"`
+		Latest         string `json:"latest"`
+		Type           string `json:"type"`           // npm --long
+		DependencyType string `json:"dependencyType"` // pnpm
+	}
+	if err := json.Unmarshal(output, &res); err != nil {
+		return nil, err
+	}
+	var deps []domain.OutdatedDependency
+	for name, info := range res {
+		deps = append(deps, domain.OutdatedDependency{
+			Name: name, Current: info.Current, Wanted: info.Wanted, Latest: info.Latest,
+			Dev: info.Type == "devDependencies" || info.DependencyType == "devDependencies",
+		})
+	}
+	return deps, nil
+}
+
+// parseYarnOutdated Yarn 1'in satır satır JSON çıktısındaki "table" kaydını okur
+func parseYarnOutdated(output []byte) []domain.OutdatedDependency {
+	var deps []domain.OutdatedDependency
+	sc := bufio.NewScanner(bytes.NewReader(output))
+	sc.Buffer(make([]byte, 0, 64*1024), 4*1024*1024)
+	for sc.Scan() {
+		var line struct {
+			Type string `json:"type"`
+			Data struct {
+				Body [][]string `json:"body"` // Package, Current, Wanted, Latest, Package Type, URL
+			} `json:"data"`
+		}
+		if json.Unmarshal(sc.Bytes(), &line) != nil || line.Type != "table" {
+			continue
+		}
+		for _, row := range line.Data.Body {
+			if len(row) < 4 {
+				continue
+			}
+			d := domain.OutdatedDependency{Name: row[0], Current: row[1], Wanted: row[2], Latest: row[3]}
+			d.Dev = len(row) > 4 && row[4] == "devDependencies"
+			deps = append(deps, d)
+		}
+	}
+	return deps
+}
+
+// parseBunOutdated "bun outdated" tablosunu okur (| Package | Current | Update | Latest |)
+func parseBunOutdated(output []byte) []domain.OutdatedDependency {
+	var deps []domain.OutdatedDependency
+	for _, line := range strings.Split(string(output), "\n") {
+		line = strings.TrimSpace(strings.ReplaceAll(line, "│", "|"))
+		if !strings.HasPrefix(line, "|") {
+			continue
+		}
+		var cols []string
+		for _, c := range strings.Split(strings.Trim(line, "|"), "|") {
+			cols = append(cols, strings.TrimSpace(c))
+		}
+		if len(cols) < 4 || cols[0] == "Package" || strings.Trim(cols[0], "-─") == "" {
+			continue
+		}
+		// "react (dev)" -> react
+		name, suffix, _ := strings.Cut(cols[0], " ")
+		deps = append(deps, domain.OutdatedDependency{
+			Name: name, Current: cols[1], Wanted: cols[2], Latest: cols[3],
+			Dev: strings.Contains(suffix, "dev"),
+		})
+	}
+	return deps
+}
+
+// --- go.mod ---
+
+type goOutdated struct{}
+
+func (goOutdated) Name() string { return "go" }
+
+func (goOutdated) Detect(dir string) bool {
+	return pathExists(filepath.Join(dir, "go.mod"))
+}
+
+func (goOutdated) Outdated(dir string) ([]domain.OutdatedDependency, error) {
+	out, err := runCheckCmd(dir, []string{"go", "list", "-m", "-u", "-json", "all"})
+	if err != nil {
+		return nil, err
+	}
+
+	// Çıktı art arda yazılmış JSON nesneleridir
+	var deps []domain.OutdatedDependency
+	dec := json.NewDecoder(bytes.NewReader(out))
+	for {
+		var mod struct {
+			Path     string
+			Version  string
+			Main     bool
+			Indirect bool
+			Update   *struct{ Version string }
+		}
+		if err := dec.Decode(&mod); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("go list çıktısı parse edilemedi: %v", err)
+		}
+		if mod.Main || mod.Update == nil {
+			continue
+		}
+		deps = append(deps, domain.OutdatedDependency{
+			Ecosystem: "go", Name: mod.Path, Current: mod.Version,
+			// Go'da aralık yok; minimal sürüm seçimi en yeni sürüme izin verir
+			Wanted: mod.Update.Version, Latest: mod.Update.Version,
+			Direct: !mod.Indirect, Dir: dir,
+		})
+	}
+	return deps, nil
+}
+
+// --- requirements.txt / pyproject.toml ---
+
+type pipOutdated struct{}
+
+func (pipOutdated) Name() string { return "pip" }
+
+func (pipOutdated) Detect(dir string) bool {
+	for _, name := range []string{"requirements.txt", "pyproject.toml", "setup.py", "Pipfile"} {
+		if pathExists(filepath.Join(dir, name)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (pipOutdated) Outdated(dir string) ([]domain.OutdatedDependency, error) {
+	out, err := runCheckCmd(dir, append(pipCommand(dir), "list", "--outdated", "--format", "json"))
+	if err != nil {
+		return nil, err
+	}
+	var res []struct {
+		Name          string `json:"name"`
+		Version       string `json:"version"`
+		LatestVersion string `json:"latest_version"`
+	}
+	if err := json.Unmarshal(out, &res); err != nil {
+		return nil, fmt.Errorf("pip çıktısı parse edilemedi: %v", err)
+	}
+
+	declared := pythonRequirements(dir)
+	var deps []domain.OutdatedDependency
+	for _, r := range res {
+		dev, direct := declared[normalizePyName(r.Name)]
+		deps = append(deps, domain.OutdatedDependency{
+			Ecosystem: "pip", Name: r.Name, Current: r.Version,
+			Wanted: r.LatestVersion, Latest: r.LatestVersion,
+			Dev: dev, Direct: direct, Dir: dir,
+		})
+	}
+	return deps, nil
+}
+
+// pipCommand projedeki sanal ortamın pip'ini, yoksa PATH'teki pip'i döndürür
+func pipCommand(dir string) []string {
+	for _, venv := range []string{".venv", "venv", "env"} {
+		bin := filepath.Join(dir, venv, "bin", "pip")
+		if runtime.GOOS == "windows" {
+			bin = filepath.Join(dir, venv, "Scripts", "pip.exe")
+		}
+		if pathExists(bin) {
+			return []string{bin}
+		}
+	}
+	if _, err := exec.LookPath("pip"); err == nil {
+		return []string{"pip"}
+	}
+	return []string{"pip3"}
+}
+
+// pyReqNameRe gereksinim satırının başındaki paket adını yakalar ("Django>=4.2", "uvicorn[standard]")
+var pyReqNameRe = regexp.MustCompile(`^\s*([A-Za-z0-9][A-Za-z0-9._-]*)`)
+
+// normalizePyName PEP 503'e göre paket adını karşılaştırılabilir hale getirir
+func normalizePyName(name string) string {
+	return strings.ToLower(strings.NewReplacer("_", "-", ".", "-").Replace(name))
+}
+
+// pythonRequirements manifestlerde doğrudan tanımlı paketleri döndürür (değer: dev bağımlılığı mı)
+func pythonRequirements(dir string) map[string]bool {
+	declared := make(map[string]bool)
+	addLine := func(line string, dev bool) {
+		if m := pyReqNameRe.FindStringSubmatch(line); m != nil {
+			name := normalizePyName(m[1])
+			if _, ok := declared[name]; !ok || !dev {
+				declared[name] = dev
+			}
+		}
+	}
+
+	for name, dev := range map[string]bool{"requirements.txt": false, "requirements-dev.txt": true, "dev-requirements.txt": true} {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			continue
+		}
+		for _, line := range strings.Split(string(data), "\n") {
+			if t := strings.TrimSpace(line); t != "" && !strings.HasPrefix(t, "#") && !strings.HasPrefix(t, "-") {
+				addLine(t, dev)
+			}
+		}
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "pyproject.toml"))
+	if err != nil {
+		return declared
+	}
+	var py struct {
+		Project struct {
+			Dependencies         []string            `toml:"dependencies"`
+			OptionalDependencies map[string][]string `toml:"optional-dependencies"`
+		} `toml:"project"`
+		Tool struct {
+			Poetry struct {
+				Dependencies    map[string]any `toml:"dependencies"`
+				DevDependencies map[string]any `toml:"dev-dependencies"`
+				Group           map[string]struct {
+					Dependencies map[string]any `toml:"dependencies"`
+				} `toml:"group"`
+			} `toml:"poetry"`
+		} `toml:"tool"`
+	}
+	if toml.Unmarshal(data, &py) != nil {
+		return declared
+	}
+	for _, dep := range py.Project.Dependencies {
+		addLine(dep, false)
+	}
+	for _, group := range py.Project.OptionalDependencies {
+		for _, dep := range group {
+			addLine(dep, true)
+		}
+	}
+	for name := range py.Tool.Poetry.Dependencies {
+		if name != "python" {
+			addLine(name, false)
+		}
+	}
+	for name := range py.Tool.Poetry.DevDependencies {
+		addLine(name, true)
+	}
+	for _, group := range py.Tool.Poetry.Group {
+		for name := range group.Dependencies {
+			addLine(name, true)
+		}
+	}
+	return declared
+}
+
+// --- composer.json ---
+
+type composerOutdated struct{}
+
+func (composerOutdated) Name() string { return "composer" }
+
+func (composerOutdated) Detect(dir string) bool {
+	return pathExists(filepath.Join(dir, "composer.json"))
+}
+
+func (composerOutdated) Outdated(dir string) ([]domain.OutdatedDependency, error) {
+	out, err := runCheckCmd(dir, []string{"composer", "outdated", "--format", "json"})
+	if err != nil {
+		return nil, err
+	}
+	var res struct {
+		Installed []struct {
+			Name         string `json:"name"`
+			Version      string `json:"version"`
+			Latest       string `json:"latest"`
+			LatestStatus string `json:"latest-status"` // semver-safe-update, update-possible, up-to-date
+		} `json:"installed"`
+	}
+	if err := json.Unmarshal(out, &res); err != nil {
+		return nil, fmt.Errorf("composer çıktısı parse edilemedi: %v", err)
+	}
+
+	var manifest struct {
+		Require    map[string]string `json:"require"`
+		RequireDev map[string]string `json:"require-dev"`
+	}
+	if data, err := os.ReadFile(filepath.Join(dir, "composer.json")); err == nil {
+		_ = json.Unmarshal(data, &manifest)
+	}
+
+	var deps []domain.OutdatedDependency
+	for _, r := range res.Installed {
+		if r.LatestStatus == "up-to-date" {
+			continue
+		}
+		// Aralık içinde kalan güncelleme varsa "semver-safe-update" döner
+		wanted := r.Version
+		if r.LatestStatus == "semver-safe-update" {
+			wanted = r.Latest
+		}
+		_, prod := manifest.Require[r.Name]
+		_, dev := manifest.RequireDev[r.Name]
+		deps = append(deps, domain.OutdatedDependency{
+			Ecosystem: "composer", Name: r.Name, Current: r.Version, Wanted: wanted, Latest: r.Latest,
+			Dev: dev, Direct: prod || dev, Dir: dir,
+		})
+	}
+	return deps, nil
+}
+
+// --- Cargo.toml ---
+
+type cargoOutdated struct{}
+
+func (cargoOutdated) Name() string { return "cargo" }
+
+func (cargoOutdated) Detect(dir string) bool {
+	return pathExists(filepath.Join(dir, "Cargo.toml"))
+}
+
+func (cargoOutdated) Outdated(dir string) ([]domain.OutdatedDependency, error) {
+	// cargo-outdated eklentisi gerekir (cargo install cargo-outdated)
+	out, err := runCheckCmd(dir, []string{"cargo", "outdated", "--root-deps-only", "--format", "json"})
+	if err != nil {
+		return nil, err
+	}
+
+	var deps []domain.OutdatedDependency
+	dec := json.NewDecoder(bytes.NewReader(out))
+	for {
+		// Workspace'te her crate için ayrı bir nesne yazılır
+		var res struct {
+			Dependencies []struct {
+				Name    string `json:"name"`
+				Project string `json:"project"`
+				Compat  string `json:"compat"`
+				Latest  string `json:"latest"`
+				Kind    string `json:"kind"` // Normal, Development, Build
+			} `json:"dependencies"`
+		}
+		if err := dec.Decode(&res); err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("cargo outdated çıktısı parse edilemedi: %v", err)
+		}
+		for _, d := range res.Dependencies {
+			// "---" uyumlu/yeni sürüm olmadığını belirtir
+			wanted, latest := d.Compat, d.Latest
+			if wanted == "---" {
+				wanted = d.Project
+			}
+			if latest == "---" {
+				latest = d.Project
+			}
+			deps = append(deps, domain.OutdatedDependency{
+				Ecosystem: "cargo", Name: d.Name, Current: d.Project, Wanted: wanted, Latest: latest,
+				Dev: d.Kind == "Development", Direct: true, Dir: dir,
+			})
+		}
+	}
+	return deps, nil
+}
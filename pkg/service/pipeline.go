@@ -0,0 +1,210 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"devterminal/pkg/domain"
+)
+
+// PipelineUnit pipeline'da çalışacak tek bir komuttur
+type PipelineUnit struct {
+	Group   int    // Aynı gruptaki birimler paralel çalışır
+	Name    string // Görünen ad (görev adı veya komut)
+	Dir     string
+	Command string
+}
+
+// PipelineStatus bir birimin pipeline içindeki durumu
+type PipelineStatus string
+
+const (
+	PipelinePending PipelineStatus = "pending"
+	PipelineRunning PipelineStatus = "running"
+	PipelinePassed  PipelineStatus = "passed"
+	PipelineFailed  PipelineStatus = "failed"
+	PipelineSkipped PipelineStatus = "skipped" // Önceki adım başarısız olduğu için çalışmadı
+	PipelineStopped PipelineStatus = "stopped" // Paralel gruptaki başka bir birim başarısız olduğu (veya iptal edildiği) için durduruldu
+)
+
+// errUnitStopped birimin kendisi başarısız olmadan durdurulduğunu belirtir
+var errUnitStopped = errors.New("durduruldu")
+
+// PipelineEvent pipeline çalışırken UI'a gönderilen durum bildirimi
+type PipelineEvent struct {
+	Unit     int // PlanPipeline'ın döndürdüğü listedeki sıra
+	Status   PipelineStatus
+	Process  *ManagedProcess // Running ve sonrasında birimin çıktısı
+	ExitCode int
+	Duration time.Duration
+	Err      error
+}
+
+// Pipelines projede kullanılabilecek pipeline'ları döndürür.
+// Proje bazlı tanımlar aynı isimli global tanımları ezer.
+func (l *Launcher) Pipelines(p *domain.Project) []domain.Pipeline {
+	project := l.Config.ProjectOverrides[strings.ToLower(p.Path)].Pipelines
+
+	var out []domain.Pipeline
+	overridden := make(map[string]bool, len(project))
+	for _, pl := range project {
+		overridden[pl.Name] = true
+	}
+	for _, pl := range l.Config.Pipelines {
+		if !overridden[pl.Name] {
+			out = append(out, pl)
+		}
+	}
+	return append(out, project...)
+}
+
+// PlanPipeline pipeline adımlarını çalıştırılabilir birimlere çevirir.
+// Görev adları projede bulunan görevlerle eşleştirilir; bulunamazsa hata döner.
+func (l *Launcher) PlanPipeline(p *domain.Project, pl domain.Pipeline) ([]PipelineUnit, error) {
+	var units []PipelineUnit
+	for group, step := range pl.Steps {
+		steps := step.Parallel
+		if len(steps) == 0 {
+			steps = []domain.PipelineStep{step}
+		}
+		for _, st := range steps {
+			u, err := pipelineUnit(p, st)
+			if err != nil {
+				return nil, fmt.Errorf("%s pipeline'ı, adım %d: %w", pl.Name, group+1, err)
+			}
+			u.Group = group
+			units = append(units, u)
+		}
+	}
+	if len(units) == 0 {
+		return nil, fmt.Errorf("%s pipeline'ında adım yok", pl.Name)
+	}
+	return units, nil
+}
+
+func pipelineUnit(p *domain.Project, st domain.PipelineStep) (PipelineUnit, error) {
+	switch {
+	case st.Script != "":
+		for _, t := range p.Tasks {
+			if t.Name == st.Script {
+				return PipelineUnit{Name: st.Script, Dir: t.Dir, Command: t.Run}, nil
+			}
+		}
+		return PipelineUnit{}, fmt.Errorf("'%s' görevi bulunamadı", st.Script)
+	case st.Command != "":
+		dir := p.Path
+		if st.Dir != "" {
+			dir = filepath.Join(p.Path, st.Dir)
+		}
+		return PipelineUnit{Name: st.Command, Dir: dir, Command: st.Command}, nil
+	}
+	return PipelineUnit{}, fmt.Errorf("script veya command tanımlı değil")
+}
+
+// RunPipeline birimleri gruplar halinde sırayla çalıştırır; bir gruptaki birimler
+// paralel başlar. Bir birim başarısız olursa aynı gruptaki diğer birimler durdurulur
+// ve kalan gruplar atlanır.
+// Olaylar events kanalına yazılır ve iş bitince kanal kapatılır.
+func (l *Launcher) RunPipeline(ctx context.Context, p *domain.Project, name string, units []PipelineUnit, events chan<- PipelineEvent) (err error) {
+	defer close(events)
+
+	// Pipeline tek kayıt olarak geçmişe yazılır (komut: adımların özeti)
+	names := make([]string, len(units))
+	for i, u := range units {
+		names[i] = u.Name
+	}
+	var failed error
+	failedCode := 0
+	started := time.Now()
+	id := l.History.Record(domain.HistoryEntry{
+		Time: started, Kind: domain.HistoryPipeline, Project: p.Path, ProjectName: p.Name,
+		Name: name, Target: name, Command: strings.Join(names, " → "), Dir: p.Path, Status: domain.HistoryRunning,
+	})
+	defer func() {
+		status, code := domain.HistoryPassed, 0
+		switch {
+		case ctx.Err() != nil:
+			status, code = domain.HistoryStopped, -1
+		case err != nil:
+			status, code = domain.HistoryFailed, failedCode
+		}
+		l.History.Finish(id, status, code, time.Since(started))
+	}()
+
+	for start := 0; start < len(units); {
+		end := start
+		for end < len(units) && units[end].Group == units[start].Group {
+			end++
+		}
+
+		if failed != nil || ctx.Err() != nil {
+			for i := start; i < end; i++ {
+				events <- PipelineEvent{Unit: i, Status: PipelineSkipped}
+			}
+			start = end
+			continue
+		}
+
+		// İlk hatada gruptaki diğer birimler beklenmeden durdurulur
+		groupCtx, cancel := context.WithCancel(ctx)
+		var wg sync.WaitGroup
+		var mu sync.Mutex
+		for i := start; i < end; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				if code, err := runPipelineUnit(groupCtx, p.Path, i, units[i], events); err != nil {
+					mu.Lock()
+					if failed == nil && !errors.Is(err, errUnitStopped) {
+						failed, failedCode = err, code
+					}
+					mu.Unlock()
+					cancel()
+				}
+			}(i)
+		}
+		wg.Wait()
+		cancel()
+		start = end
+	}
+	if failed == nil && ctx.Err() != nil {
+		failed = ctx.Err()
+	}
+	return failed
+}
+
+// runPipelineUnit tek bir birimi çalıştırır, bitmesini bekler ve çıkış kodunu döndürür
+func runPipelineUnit(ctx context.Context, projectPath string, i int, u PipelineUnit, events chan<- PipelineEvent) (int, error) {
+	mp := newManagedProcess(0, ProcessSpec{Name: u.Name, ProjectPath: projectPath, Dir: u.Dir, Command: u.Command})
+	if err := mp.start(); err != nil {
+		events <- PipelineEvent{Unit: i, Status: PipelineFailed, Process: mp, ExitCode: -1, Err: err}
+		return -1, err
+	}
+	events <- PipelineEvent{Unit: i, Status: PipelineRunning, Process: mp}
+
+	stopped := false
+	select {
+	case <-mp.Done():
+	case <-ctx.Done():
+		stopped = true
+		_ = mp.Stop()
+	}
+
+	info := mp.Info()
+	if stopped && info.Status != ProcessExited {
+		events <- PipelineEvent{Unit: i, Status: PipelineStopped, Process: mp, ExitCode: info.ExitCode, Duration: info.Uptime(), Err: errUnitStopped}
+		return info.ExitCode, errUnitStopped
+	}
+	if info.Status != ProcessExited {
+		err := fmt.Errorf("%s başarısız (exit %d)", u.Name, info.ExitCode)
+		events <- PipelineEvent{Unit: i, Status: PipelineFailed, Process: mp, ExitCode: info.ExitCode, Duration: info.Uptime(), Err: err}
+		return info.ExitCode, err
+	}
+	events <- PipelineEvent{Unit: i, Status: PipelinePassed, Process: mp, Duration: info.Uptime()}
+	return 0, nil
+}
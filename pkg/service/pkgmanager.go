@@ -0,0 +1,209 @@
+package service
+
+import (
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// PackageManager bir dizin için çözümlenmiş JS paket yöneticisidir
+type PackageManager struct {
+	Name     string // npm, pnpm, yarn veya bun
+	Version  string // package.json "packageManager" alanındaki sürüm (örn: "9.1.0"), bilinmiyorsa boş
+	Root     string // Yöneticinin tespit edildiği dizin (genelde workspace kökü)
+	Lockfile string // Bulunan kilit dosyasının adı (yoksa boş)
+	Source   string // Tespit kaynağı: "packageManager", "lockfile" veya "default"
+}
+
+// pmLockfiles kilit dosyalarını aynı dizinde birden fazlası varsa öncelik sırasıyla listeler
+var pmLockfiles = []struct{ file, name string }{
+	{"bun.lock", "bun"}, // Bun 1.2+ metin formatı
+	{"bun.lockb", "bun"},
+	{"pnpm-lock.yaml", "pnpm"},
+	{"yarn.lock", "yarn"},
+	{"package-lock.json", "npm"},
+	{"npm-shrinkwrap.json", "npm"},
+}
+
+// ResolvePackageManager dizindeki paket yöneticisini ve sürümünü bulur.
+// Dizinde ipucu yoksa workspace köküne (veya git deposunun köküne) kadar yukarı çıkılır;
+// her seviyede corepack "packageManager" alanı kilit dosyalarından önce gelir.
+// Git dışındaki projelerde ev dizinine ulaşılınca durulur: ~/yarn.lock gibi başıboş
+// bir kilit dosyası projenin yöneticisini değiştirmemeli.
+func ResolvePackageManager(dir string) PackageManager {
+	dir = filepath.Clean(dir)
+	home, _ := os.UserHomeDir()
+	if home != "" {
+		home = filepath.Clean(home)
+	}
+	for d := dir; ; d = filepath.Dir(d) {
+		if d != dir && d == home {
+			return PackageManager{Name: "npm", Root: dir, Source: "default"}
+		}
+		if pm, ok := packageManagerAt(d); ok {
+			return pm
+		}
+		// Workspace veya depo kökünün üstüne çıkma
+		if hasWorkspaceConfig(d) || pathExists(filepath.Join(d, ".git")) {
+			return PackageManager{Name: "npm", Root: d, Source: "default"}
+		}
+		if d == filepath.Dir(d) {
+			return PackageManager{Name: "npm", Root: dir, Source: "default"}
+		}
+	}
+}
+
+// packageManagerAt sadece verilen dizindeki packageManager alanına ve kilit dosyalarına bakar
+func packageManagerAt(dir string) (PackageManager, bool) {
+	if data, err := os.ReadFile(filepath.Join(dir, "package.json")); err == nil {
+		var pkg struct {
+			PackageManager string `json:"packageManager"`
+		}
+		if json.Unmarshal(data, &pkg) == nil && pkg.PackageManager != "" {
+			// "pnpm@9.1.0+sha512.abc" -> pnpm, 9.1.0
+			name, version, _ := strings.Cut(pkg.PackageManager, "@")
+			version, _, _ = strings.Cut(version, "+")
+			pm := PackageManager{Name: name, Version: version, Root: dir, Source: "packageManager"}
+			pm.Lockfile = lockfileAt(dir)
+			return pm, true
+		}
+	}
+	for _, lf := range pmLockfiles {
+		if pathExists(filepath.Join(dir, lf.file)) {
+			return PackageManager{Name: lf.name, Root: dir, Lockfile: lf.file, Source: "lockfile"}, true
+		}
+	}
+	return PackageManager{}, false
+}
+
+// lockfileAt dizindeki ilk kilit dosyasının adını döndürür
+func lockfileAt(dir string) string {
+	for _, lf := range pmLockfiles {
+		if pathExists(filepath.Join(dir, lf.file)) {
+			return lf.file
+		}
+	}
+	return ""
+}
+
+// pathExists dosya veya klasör var mı
+func pathExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
+
+// String "pnpm@9.1.0" veya sürüm bilinmiyorsa "pnpm"
+func (pm PackageManager) String() string {
+	if pm.Version != "" {
+		return pm.Name + "@" + pm.Version
+	}
+	return pm.Name
+}
+
+// Berry Yarn 2+ mı (Yarn 2+ da "outdated" komutu yoktur)
+func (pm PackageManager) Berry() bool {
+	if pm.Name != "yarn" {
+		return false
+	}
+	if pm.Version != "" {
+		return !strings.HasPrefix(pm.Version, "1.") && pm.Version != "1"
+	}
+	return pathExists(filepath.Join(pm.Root, ".yarnrc.yml"))
+}
+
+// Available yöneticinin PATH'te olup olmadığını kontrol eder
+func (pm PackageManager) Available() bool {
+	_, err := exec.LookPath(pm.Name)
+	return err == nil
+}
+
+// InstalledVersion yöneticinin kurulu sürümünü "<pm> --version" ile okur
+func (pm PackageManager) InstalledVersion() (string, error) {
+	out, err := exec.Command(pm.Name, "--version").Output()
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// Run package.json scriptini çalıştıran komutu döndürür (örn: ["pnpm", "run", "dev"])
+func (pm PackageManager) Run(script string, args ...string) []string {
+	argv := []string{pm.Name, "run", script}
+	if len(args) > 0 {
+		// npm'de ek argümanlar "--" olmadan scripte değil npm'e gider
+		if pm.Name == "npm" {
+			argv = append(argv, "--")
+		}
+		argv = append(argv, args...)
+	}
+	return argv
+}
+
+// Install bağımlılıkları kuran komutu döndürür
+func (pm PackageManager) Install() []string {
+	return []string{pm.Name, "install"}
+}
+
+// Outdated eski paketleri listeleyen komutu döndürür; Yarn 2+ için nil
+// (npm ve pnpm JSON, Yarn 1 satır satır JSON, bun tablo çıktısı verir)
+func (pm PackageManager) Outdated() []string {
+	switch pm.Name {
+	case "pnpm":
+		return []string{"pnpm", "outdated", "--format", "json"}
+	case "yarn":
+		if pm.Berry() {
+			return nil
+		}
+		return []string{"yarn", "outdated", "--json"}
+	case "bun":
+		return []string{"bun", "outdated"}
+	}
+	return []string{"npm", "outdated", "--json"}
+}
+
+// Audit güvenlik açıklarını JSON olarak listeleyen komutu döndürür
+// (npm v7+ "vulnerabilities", pnpm npm v6 "advisories", Yarn satır satır JSON, bun paket -> advisory listesi)
+func (pm PackageManager) Audit() []string {
+	switch pm.Name {
+	case "pnpm":
+		return []string{"pnpm", "audit", "--json"}
+	case "yarn":
+		if pm.Berry() {
+			return []string{"yarn", "npm", "audit", "--all", "--recursive", "--json"}
+		}
+		return []string{"yarn", "audit", "--json"}
+	case "bun":
+		return []string{"bun", "audit", "--json"}
+	}
+	return []string{"npm", "audit", "--json"}
+}
+
+// Exec paket binary'sini çalıştıran komutu döndürür (npx, pnpm exec, yarn, bunx)
+func (pm PackageManager) Exec(bin string, args ...string) []string {
+	var argv []string
+	switch pm.Name {
+	case "pnpm":
+		argv = []string{"pnpm", "exec", bin}
+	case "yarn":
+		argv = []string{"yarn", bin}
+	case "bun":
+		argv = []string{"bunx", bin}
+	default:
+		argv = []string{"npx", bin}
+	}
+	return append(argv, args...)
+}
+
+// commandLine argv'yi terminalde çalıştırılacak tek satıra çevirir
+func commandLine(argv []string) string {
+	parts := make([]string, len(argv))
+	for i, a := range argv {
+		parts[i] = a
+		if a == "" || strings.ContainsAny(a, " \t\"'") {
+			parts[i] = quoteShellArg(a)
+		}
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,212 @@
+package service
+
+import (
+	"fmt"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"devterminal/pkg/domain"
+)
+
+// PolicyReport projelerin doctor.policy kurallarına göre değerlendirmesidir
+type PolicyReport struct {
+	Violations      []domain.PolicyViolation
+	Projects        int      // Değerlendirilen proje sayısı
+	UnknownLicenses int      // Lisansı okunamayan paketler (izin listesi varken ihlal sayılmaz)
+	Errors          []string // Geçersiz kurallar ve okunamayan kilit dosyaları
+}
+
+// Count projenin ihlal sayısı
+func (r *PolicyReport) Count(project string) int {
+	n := 0
+	for _, v := range r.Violations {
+		if v.Project == project {
+			n++
+		}
+	}
+	return n
+}
+
+// minimumRule "next>=14" kuralının ayrıştırılmış halidir
+type minimumRule struct {
+	name, floor string
+	version     Semver
+}
+
+// parseMinimumRules doctor.policy.minimum kurallarını ayrıştırır; geçersiz olanlar hata olarak döner
+func parseMinimumRules(rules []string) ([]minimumRule, []string) {
+	var parsed []minimumRule
+	var errs []string
+	for _, rule := range rules {
+		name, floor, ok := strings.Cut(strings.ReplaceAll(rule, " ", ""), ">=")
+		v, valid := comparableVersion(floor)
+		if !ok || name == "" || !valid {
+			errs = append(errs, fmt.Sprintf("policy.minimum: %q geçersiz (örn: next>=14)", rule))
+			continue
+		}
+		parsed = append(parsed, minimumRule{name: name, floor: floor, version: v})
+	}
+	return parsed, errs
+}
+
+// bannedMatch paket adı yasaklı listesindeki bir adla veya glob desenle eşleşiyor mu
+func bannedMatch(banned []string, name string) (string, bool) {
+	for _, b := range banned {
+		if b == name {
+			return b, true
+		}
+		if ok, _ := path.Match(b, name); ok {
+			return b, true
+		}
+	}
+	return "", false
+}
+
+// licenseAllowed SPDX ifadesini izin listesine göre değerlendirir:
+// "A OR B" seçeneklerden biri, "A AND B" hepsi izinliyse geçer
+func licenseAllowed(allowed map[string]bool, expr string) bool {
+	expr = strings.NewReplacer("(", "", ")", "").Replace(expr)
+	for _, option := range strings.Split(expr, " OR ") {
+		ok := true
+		for _, part := range strings.Split(option, " AND ") {
+			// "GPL-2.0 WITH Classpath-exception-2.0" -> lisans kimliği
+			id, _, _ := strings.Cut(strings.TrimSpace(part), " WITH ")
+			if !allowed[strings.ToLower(id)] {
+				ok = false
+				break
+			}
+		}
+		if ok {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckPolicy evaluates every project against doctor.policy without touching the network:
+// direct dependencies (package.json, go.mod, requirements) are checked against the banned list
+// and version floors using lockfile versions, and every package resolved in the lockfiles and
+// go.sum is checked against the license allowlist using installed metadata (see BuildSBOM).
+func (d *Doctor) CheckPolicy(projects []domain.Project) (*PolicyReport, error) {
+	if d.Config.Doctor.Policy.Empty() {
+		return nil, fmt.Errorf("doctor.policy altında kural tanımlı değil (banned, minimum, licenses)")
+	}
+	return d.checkPolicy(projects, true), nil
+}
+
+// CheckDependencyRules sadece yasaklı paket ve minimum sürüm kurallarını uygular. Lisans kontrolü
+// node_modules ve Go modül önbelleğini okuduğu için tarama sırasında (sağlık skoru) kullanılmaz.
+func (d *Doctor) CheckDependencyRules(p *domain.Project) *PolicyReport {
+	return d.checkPolicy([]domain.Project{*p}, false)
+}
+
+// checkPolicy kuralları uygular; licenses false ise lisans izin listesi atlanır
+func (d *Doctor) checkPolicy(projects []domain.Project, licenses bool) *PolicyReport {
+	policy := d.Config.Doctor.Policy
+	report := &PolicyReport{Projects: len(projects)}
+	minimums, errs := parseMinimumRules(policy.Minimum)
+	report.Errors = append(report.Errors, errs...)
+	allowed := make(map[string]bool)
+	for _, l := range policy.Licenses {
+		allowed[strings.ToLower(strings.TrimSpace(l))] = true
+	}
+
+	// İhlaller matristeki proje adlarıyla raporlanır (aynı adlı projeler ayrıştırılmış)
+	labels := matrixLabels(projects)
+
+	// Yasaklı paketler ve alt sürümler: doğrudan bağımlılıklar (matristeki gibi kilitli sürümle)
+	mx := BuildMatrix(projects)
+	report.Errors = append(report.Errors, mx.Errors...)
+	for _, row := range mx.Rows {
+		for _, c := range row.Cells {
+			v := domain.PolicyViolation{Project: c.Project, Ecosystem: row.Ecosystem, Name: row.Name, Version: c.Version, Dev: c.Dev}
+			if rule, ok := bannedMatch(policy.Banned, row.Name); ok {
+				v.Rule, v.Detail = domain.PolicyBanned, "yasaklı ("+rule+")"
+				report.Violations = append(report.Violations, v)
+			}
+			for _, r := range minimums {
+				if r.name != row.Name {
+					continue
+				}
+				if current, ok := comparableVersion(c.Version); ok && current.Compare(r.version) < 0 {
+					v.Rule, v.Detail = domain.PolicyMinimum, ">="+r.floor+" gerekli"
+					report.Violations = append(report.Violations, v)
+				}
+			}
+		}
+	}
+	// Python paketlerinin sürümü kilit dosyasından okunmadığı için sadece yasaklı listesi uygulanır
+	if len(policy.Banned) > 0 {
+		pyBanned := make([]string, len(policy.Banned))
+		for i, b := range policy.Banned {
+			pyBanned[i] = normalizePyName(b)
+		}
+		for i := range projects {
+			p := &projects[i]
+			for _, dir := range projectDirs(p) {
+				for name, dev := range pythonRequirements(dir) {
+					if rule, ok := bannedMatch(pyBanned, name); ok {
+						report.Violations = append(report.Violations, domain.PolicyViolation{
+							Project: labels[p.Path], Ecosystem: "pip", Name: name, Rule: domain.PolicyBanned, Detail: "yasaklı (" + rule + ")", Dev: dev,
+						})
+					}
+				}
+			}
+		}
+	}
+
+	// Lisans izin listesi: dolaylı bağımlılıklar dahil kilit dosyasındaki tüm paketler
+	if licenses && len(allowed) > 0 {
+		for i := range projects {
+			p := &projects[i]
+			sbom, err := d.BuildSBOM(p)
+			if err != nil {
+				// Manifesti olmayan projeler (örn: sadece Docker) atlanır
+				if hasLockableManifest(p) {
+					report.Errors = append(report.Errors, labels[p.Path]+": "+err.Error())
+				}
+				continue
+			}
+			for _, c := range sbom.Components {
+				if c.License == "" {
+					report.UnknownLicenses++
+					continue
+				}
+				if !licenseAllowed(allowed, c.License) {
+					eco := c.Ecosystem
+					if eco == "golang" {
+						eco = "go"
+					}
+					report.Violations = append(report.Violations, domain.PolicyViolation{
+						Project: labels[p.Path], Ecosystem: eco, Name: c.Name, Version: c.Version, Rule: domain.PolicyLicense, Detail: c.License + " izinli değil",
+					})
+				}
+			}
+		}
+	}
+
+	rules := map[domain.PolicyRule]int{domain.PolicyBanned: 0, domain.PolicyMinimum: 1, domain.PolicyLicense: 2}
+	sort.SliceStable(report.Violations, func(i, j int) bool {
+		a, b := report.Violations[i], report.Violations[j]
+		if a.Project != b.Project {
+			return a.Project < b.Project
+		}
+		if a.Rule != b.Rule {
+			return rules[a.Rule] < rules[b.Rule]
+		}
+		return a.Name < b.Name
+	})
+	return report
+}
+
+// hasLockableManifest projede SBOM'a girebilecek bir manifest (package.json, go.mod) var mı
+func hasLockableManifest(p *domain.Project) bool {
+	for _, dir := range projectDirs(p) {
+		if pathExists(filepath.Join(dir, "package.json")) || pathExists(filepath.Join(dir, "go.mod")) {
+			return true
+		}
+	}
+	return false
+}
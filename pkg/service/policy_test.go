@@ -0,0 +1,102 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	"devterminal/pkg/domain"
+)
+
+func TestParseMinimumRules(t *testing.T) {
+	rules, errs := parseMinimumRules([]string{"next>=14", "github.com/gin-gonic/gin >= 1.9", "react", ">=1.0", "vue>=abc"})
+	if len(rules) != 2 || rules[0].name != "next" || rules[0].floor != "14" || rules[1].name != "github.com/gin-gonic/gin" || rules[1].floor != "1.9" {
+		t.Errorf("geçerli kurallar: %+v", rules)
+	}
+	if len(errs) != 3 {
+		t.Errorf("%d hata, beklenen 3: %q", len(errs), errs)
+	}
+}
+
+func TestBannedMatch(t *testing.T) {
+	banned := []string{"moment", "@types/*", "left-*"}
+	tests := map[string]string{
+		"moment":          "moment",
+		"moment-timezone": "",
+		"@types/node":     "@types/*",
+		"@types/a/b":      "",
+		"left-pad":        "left-*",
+		"react":           "",
+	}
+	for name, want := range tests {
+		rule, ok := bannedMatch(banned, name)
+		if rule != want || ok != (want != "") {
+			t.Errorf("bannedMatch(%q) = (%q, %v), beklenen %q", name, rule, ok, want)
+		}
+	}
+}
+
+func TestLicenseAllowed(t *testing.T) {
+	allowed := map[string]bool{"mit": true, "apache-2.0": true, "gpl-2.0-only": true}
+	tests := map[string]bool{
+		"MIT":                         true,
+		"ISC":                         false,
+		"MIT OR GPL-3.0":              true,
+		"GPL-3.0 OR ISC":              false,
+		"MIT AND Apache-2.0":          true,
+		"MIT AND ISC":                 false,
+		"(MIT AND ISC) OR Apache-2.0": true,
+		"GPL-2.0-only WITH Classpath-exception-2.0": true,
+	}
+	for expr, want := range tests {
+		if got := licenseAllowed(allowed, expr); got != want {
+			t.Errorf("licenseAllowed(%q) = %v, beklenen %v", expr, got, want)
+		}
+	}
+}
+
+func TestCheckDependencyRules(t *testing.T) {
+	dir := t.TempDir()
+	writeTree(t, dir, map[string]string{
+		"go.mod": `module example.com/app
+
+go 1.22
+
+require (
+	github.com/gin-gonic/gin v1.8.2
+	github.com/labstack/echo/v4 v4.11.0
+	github.com/pkg/errors v0.9.1
+)
+`,
+		"requirements.txt":     "Flask==3.0\nPyYAML>=6\n",
+		"requirements-dev.txt": "nose\n",
+	})
+	cfg := &domain.Config{Doctor: domain.DoctorOptions{Policy: domain.PolicyOptions{
+		Banned:   []string{"github.com/pkg/errors", "nose", "pyyaml"},
+		Minimum:  []string{"github.com/gin-gonic/gin>=1.9", "github.com/labstack/echo/v4>=4.10", "bad-rule"},
+		Licenses: []string{"MIT"},
+	}}}
+	p := &domain.Project{Name: "app", Path: dir}
+	report := NewDoctor(cfg).CheckDependencyRules(p)
+
+	var got []string
+	for _, v := range report.Violations {
+		got = append(got, string(v.Rule)+" "+v.Ecosystem+" "+v.Name)
+	}
+	want := []string{
+		"banned go github.com/pkg/errors",
+		"banned pip nose",
+		"banned pip pyyaml",
+		"minimum go github.com/gin-gonic/gin",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("ihlaller:\n%s\nbeklenen:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+	if len(report.Errors) != 1 || !strings.Contains(report.Errors[0], "bad-rule") {
+		t.Errorf("geçersiz kural hatası: %q", report.Errors)
+	}
+	for _, v := range report.Violations {
+		if v.Name == "nose" && !v.Dev {
+			t.Errorf("requirements-dev.txt paketi dev olarak işaretlenmedi: %+v", v)
+		}
+	}
+}
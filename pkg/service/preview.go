@@ -0,0 +1,212 @@
+package service
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"text/template/parse"
+
+	"devterminal/pkg/domain"
+)
+
+// TemplateError başlatma şablonunun hangi alanda ve konumda bozulduğunu belirtir
+type TemplateError struct {
+	Template string // Config anahtarı (örn: "commands.launch_full")
+	Field    string // Sorunlu alan veya fonksiyon (örn: ".FrontendPth", "shellquote")
+	Pos      string // Satır:sütun (örn: "1:14")
+	Err      error
+}
+
+func (e *TemplateError) Error() string {
+	var b strings.Builder
+	b.WriteString(e.Template)
+	if e.Pos != "" {
+		b.WriteString(" (" + e.Pos + ")")
+	}
+	if e.Field != "" {
+		b.WriteString(": " + e.Field)
+	}
+	b.WriteString(": " + templateErrorDetail(e.Err))
+	return b.String()
+}
+
+func (e *TemplateError) Unwrap() error { return e.Err }
+
+var (
+	// template: launch_full:1:14: executing "launch_full" at <.FrontendPth>: can't evaluate field ...
+	execErrRe = regexp.MustCompile(`^template: [^:]+:(\d+:\d+): executing "[^"]*" at <([^>]*)>: (.*)$`)
+	// template: launch_full:1: function "shellquote" not defined
+	parseErrRe = regexp.MustCompile(`^template: [^:]+:(\d+(?::\d+)?): (.*)$`)
+	funcErrRe  = regexp.MustCompile(`function "([^"]+)" not defined`)
+)
+
+// newTemplateError text/template hatasını alan ve konum bilgisiyle sarar
+func newTemplateError(name string, err error) *TemplateError {
+	te := &TemplateError{Template: name, Err: err}
+	msg := err.Error()
+	if m := execErrRe.FindStringSubmatch(msg); m != nil {
+		te.Pos, te.Field = m[1], m[2]
+	} else if m := parseErrRe.FindStringSubmatch(msg); m != nil {
+		te.Pos = m[1]
+		if f := funcErrRe.FindStringSubmatch(m[2]); f != nil {
+			te.Field = f[1]
+		}
+	}
+	return te
+}
+
+// templateErrorDetail "template: x:1:2: ..." önekini atıp asıl mesajı döndürür
+func templateErrorDetail(err error) string {
+	msg := err.Error()
+	if m := execErrRe.FindStringSubmatch(msg); m != nil {
+		return m[3]
+	}
+	if m := parseErrRe.FindStringSubmatch(msg); m != nil {
+		return m[2]
+	}
+	return msg
+}
+
+// launchTemplate moda ait config anahtarını ve şablonu döndürür
+func (l *Launcher) launchTemplate(mode string) (string, string, error) {
+	switch mode {
+	case "frontend":
+		return "commands.launch_frontend", l.Config.Commands.LaunchFrontend, nil
+	case "backend":
+		return "commands.launch_backend", l.Config.Commands.LaunchBackend, nil
+	case "full":
+		return "commands.launch_full", l.Config.Commands.LaunchFull, nil
+	}
+	return "", "", fmt.Errorf("unknown mode: %s", mode)
+}
+
+// emptyTemplateFields şablonda kullanılan ama boş değere genişleyen alanları bulur
+// (örn: frontend'i olmayan projede {{.FrontendPath}})
+func emptyTemplateFields(name, text string, data *TemplateData) []string {
+	tmpl, err := parseTemplate(name, text, data, quoteArg)
+	if err != nil || tmpl.Tree == nil {
+		return nil
+	}
+	seen := make(map[string]bool)
+	var empty []string
+	var walk func(n parse.Node)
+	walk = func(n parse.Node) {
+		switch n := n.(type) {
+		case *parse.ListNode:
+			if n == nil {
+				return
+			}
+			for _, c := range n.Nodes {
+				walk(c)
+			}
+		case *parse.ActionNode:
+			walk(n.Pipe)
+		case *parse.PipeNode:
+			if n == nil {
+				return
+			}
+			for _, c := range n.Cmds {
+				for _, arg := range c.Args {
+					walk(arg)
+				}
+			}
+		case *parse.IfNode:
+			walk(n.Pipe)
+			walk(n.List)
+			walk(n.ElseList)
+		case *parse.RangeNode:
+			walk(n.Pipe)
+		case *parse.WithNode:
+			walk(n.Pipe)
+		case *parse.FieldNode:
+			field := n.String()
+			if seen[field] {
+				return
+			}
+			seen[field] = true
+			// Sıfır değerler (boş metin, 0, tanımsız .Env anahtarı) boş sayılır
+			if v, err := renderTemplate(name, "{{if "+field+"}}x{{end}}", data); err == nil && v == "" {
+				empty = append(empty, field)
+			}
+		}
+	}
+	walk(tmpl.Tree.Root)
+	return empty
+}
+
+// CommandPreview çalıştırılacak tek bir süreç çağrısıdır
+type CommandPreview struct {
+	Title string
+	Dir   string
+	Line  string   // Genişletilmiş komut satırı
+	Argv  []string // Sürece verilecek argümanlar (parseArgs veya terminal backend'i)
+}
+
+// LaunchPreview bir başlatmanın hiçbir şey çalıştırılmadan önceki görünümüdür
+type LaunchPreview struct {
+	Mode     string
+	Source   string        // Komutları üreten: şablon anahtarı, terminal backend'i veya supervisor
+	Template string        // Ham şablon (şablon kullanılıyorsa)
+	Data     *TemplateData // Şablonun genişletildiği bağlam
+	Commands []CommandPreview
+	Warnings []string
+	Err      error // Şablon hatası (*TemplateError) veya mod hatası
+}
+
+// PreviewLaunch LaunchProject / StartSupervised / sıralı başlatmanın çalıştıracağı
+// komutları hiçbir şey başlatmadan hesaplar
+func (l *Launcher) PreviewLaunch(p *domain.Project, mode string) LaunchPreview {
+	pv := LaunchPreview{Mode: mode}
+	reqs := projectRequests(p, mode)
+
+	name, text, err := l.launchTemplate(mode)
+	if err != nil {
+		pv.Err = err
+		return pv
+	}
+
+	switch {
+	case l.Config.EmbeddedLaunch:
+		pv.Source = "Gömülü (Supervisor)"
+		for _, r := range reqs {
+			pv.Commands = append(pv.Commands, CommandPreview{Title: r.Title, Dir: r.Dir, Line: r.Command, Argv: shellArgs(r.Command)})
+		}
+	case strings.TrimSpace(text) == "" || l.NeedsOrderedStart(p, mode):
+		pv.Source = "Terminal backend (" + l.Terminal.Name() + ")"
+		if strings.TrimSpace(text) != "" {
+			pv.Warnings = append(pv.Warnings, name+" sıralı başlatmada (backend_ready/frontend_ready) kullanılmaz")
+		}
+		for _, r := range reqs {
+			for _, tc := range l.Terminal.Commands([]TerminalRequest{r}) {
+				pv.Commands = append(pv.Commands, CommandPreview{Title: r.Title, Dir: r.Dir, Line: r.Command, Argv: tc.Args})
+			}
+		}
+	default:
+		pv.Source = name
+		pv.Template = text
+		pv.Data = l.newTemplateData(p, mode)
+		expanded, err := renderTemplate(name, text, pv.Data)
+		if err != nil {
+			pv.Err = err
+			return pv
+		}
+		for _, field := range emptyTemplateFields(name, text, pv.Data) {
+			pv.Warnings = append(pv.Warnings, field+" boş değere genişliyor")
+		}
+		argv := parseArgs(expanded)
+		if len(argv) == 0 {
+			pv.Err = fmt.Errorf("%s boş komuta genişliyor", name)
+		}
+		pv.Commands = append(pv.Commands, CommandPreview{Title: launchModeName(mode), Dir: p.Path, Line: expanded, Argv: argv})
+	}
+
+	if len(reqs) == 0 && pv.Template == "" {
+		pv.Warnings = append(pv.Warnings, "bu modda başlatılacak frontend/backend bulunamadı")
+	}
+	for _, r := range reqs {
+		if r.Command == "" {
+			pv.Warnings = append(pv.Warnings, r.Title+" için başlatma komutu tanımlı değil")
+		}
+	}
+	return pv
+}
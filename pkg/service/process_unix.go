@@ -0,0 +1,23 @@
+//go:build !windows
+
+package service
+
+import (
+	"os/exec"
+	"syscall"
+)
+
+// setProcessGroup süreci kendi grubunda başlatır, böylece alt süreçleriyle birlikte durdurulabilir
+func setProcessGroup(c *exec.Cmd) {
+	c.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+}
+
+// terminateProcessTree sürece ve alt süreçlerine SIGTERM gönderir
+func terminateProcessTree(pid int) error {
+	return syscall.Kill(-pid, syscall.SIGTERM)
+}
+
+// killProcessTree süreci ve alt süreçlerini zorla sonlandırır
+func killProcessTree(pid int) error {
+	return syscall.Kill(-pid, syscall.SIGKILL)
+}
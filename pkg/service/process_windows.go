@@ -0,0 +1,24 @@
+//go:build windows
+
+package service
+
+import (
+	"os/exec"
+	"strconv"
+	"syscall"
+)
+
+// setProcessGroup süreci yeni bir process grubunda başlatır
+func setProcessGroup(c *exec.Cmd) {
+	c.SysProcAttr = &syscall.SysProcAttr{CreationFlags: syscall.CREATE_NEW_PROCESS_GROUP}
+}
+
+// terminateProcessTree Windows'ta nazik sonlandırma olmadığı için taskkill /T kullanır
+func terminateProcessTree(pid int) error {
+	return exec.Command("taskkill", "/T", "/PID", strconv.Itoa(pid)).Run()
+}
+
+// killProcessTree süreci ve alt süreçlerini zorla sonlandırır
+func killProcessTree(pid int) error {
+	return exec.Command("taskkill", "/T", "/F", "/PID", strconv.Itoa(pid)).Run()
+}
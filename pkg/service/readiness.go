@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"devterminal/pkg/domain"
+)
+
+// defaultReadyTimeout probe için zaman aşımı tanımlı değilse kullanılır
+const defaultReadyTimeout = 60 * time.Second
+
+// readyPollInterval probe denemeleri arasındaki bekleme
+const readyPollInterval = 500 * time.Millisecond
+
+// ErrProbeUnsupported log probe'u log akışı olmayan (terminal) süreçlerde kullanılamaz
+var ErrProbeUnsupported = errors.New("log kontrolü sadece gömülü modda desteklenir")
+
+// ProbeTimeout probe'un zaman aşımını döndürür
+func ProbeTimeout(probe domain.ReadinessProbe) time.Duration {
+	if d, err := time.ParseDuration(probe.Timeout); err == nil && d > 0 {
+		return d
+	}
+	return defaultReadyTimeout
+}
+
+// DescribeProbe probe'u kullanıcıya gösterilecek kısa metne çevirir
+func DescribeProbe(probe domain.ReadinessProbe) string {
+	switch {
+	case probe.TCP != "":
+		return "tcp " + probeAddr(probe.TCP)
+	case probe.HTTP != "":
+		return "http " + probe.HTTP
+	case probe.Log != "":
+		return "log /" + probe.Log + "/"
+	}
+	return ""
+}
+
+// WaitReady probe başarılı olana, zaman aşımına, ctx iptaline veya exited kapanana
+// (süreç sonlandı ve yeniden başlatılmayacak) kadar bekler. logs sadece log probe'u için gereklidir; nil ise
+// ErrProbeUnsupported döner. Süreci izlenemeyen (terminal) modda exited nil verilir.
+func WaitReady(ctx context.Context, probe domain.ReadinessProbe, logs *LogBuffer, exited <-chan struct{}) error {
+	if probe.IsZero() {
+		return nil
+	}
+
+	check, err := probeCheck(probe, logs)
+	if err != nil {
+		return err
+	}
+
+	timeout := ProbeTimeout(probe)
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(readyPollInterval)
+	defer ticker.Stop()
+	for {
+		if check(ctx) {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return fmt.Errorf("%s %s içinde hazır olmadı", DescribeProbe(probe), timeout)
+			}
+			return ctx.Err()
+		case <-exited:
+			// Son çıktı satırı hazır mesajı olabilir
+			if check(ctx) {
+				return nil
+			}
+			return fmt.Errorf("süreç hazır olmadan sonlandı")
+		case <-ticker.C:
+		}
+	}
+}
+
+// probeCheck probe türüne göre tek seferlik kontrol fonksiyonu üretir
+func probeCheck(probe domain.ReadinessProbe, logs *LogBuffer) (func(context.Context) bool, error) {
+	switch {
+	case probe.TCP != "":
+		addr := probeAddr(probe.TCP)
+		return func(ctx context.Context) bool {
+			conn, err := net.DialTimeout("tcp", addr, time.Second)
+			if err != nil {
+				return false
+			}
+			conn.Close()
+			return true
+		}, nil
+
+	case probe.HTTP != "":
+		client := &http.Client{Timeout: 2 * time.Second}
+		return func(ctx context.Context) bool {
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, probe.HTTP, nil)
+			if err != nil {
+				return false
+			}
+			resp, err := client.Do(req)
+			if err != nil {
+				return false
+			}
+			resp.Body.Close()
+			return resp.StatusCode >= 200 && resp.StatusCode < 300
+		}, nil
+
+	case probe.Log != "":
+		if logs == nil {
+			return nil, ErrProbeUnsupported
+		}
+		re, err := regexp.Compile(probe.Log)
+		if err != nil {
+			return nil, fmt.Errorf("geçersiz log regex'i: %w", err)
+		}
+		return func(ctx context.Context) bool {
+			// Sadece sürecin yazdığı satırlar: "▶ komut" satırı regex'e takılmamalı
+			for _, line := range logs.OutputLines() {
+				if re.MatchString(line) {
+					return true
+				}
+			}
+			return false
+		}, nil
+	}
+	return nil, fmt.Errorf("probe tanımlı değil")
+}
+
+// probeAddr sadece port verilmişse localhost'a tamamlar
+func probeAddr(s string) string {
+	s = strings.TrimSpace(s)
+	if !strings.Contains(s, ":") {
+		return "localhost:" + s
+	}
+	if strings.HasPrefix(s, ":") {
+		return "localhost" + s
+	}
+	return s
+}
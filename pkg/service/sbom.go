@@ -0,0 +1,588 @@
+package service
+
+import (
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"devterminal/pkg/domain"
+)
+
+// SBOMFormat dışa aktarılan yazılım malzeme listesinin biçimidir
+type SBOMFormat string
+
+const (
+	SBOMCycloneDX SBOMFormat = "cyclonedx" // CycloneDX 1.5 JSON
+	SBOMSPDX      SBOMFormat = "spdx"      // SPDX 2.3 JSON
+)
+
+// SBOMComponent kilit dosyasında veya go.sum'da çözümlenmiş tek bir paket sürümüdür
+type SBOMComponent struct {
+	Ecosystem string // npm veya golang (purl türü)
+	Name      string
+	Version   string
+	PURL      string
+	License   string // Kurulu paketten okunan SPDX ifadesi (okunamadıysa boş)
+}
+
+// SBOM projenin tüm bağımlılıklarıdır (dolaylı bağımlılıklar dahil)
+type SBOM struct {
+	Project    string
+	Version    string // Kök package.json sürümü (varsa)
+	Components []SBOMComponent
+	Sources    []string // Okunan kilit dosyaları (projeye göre yol)
+	Errors     []string // Okunamayan kaynaklar (örn: "npm: kilit dosyası yok")
+	Created    time.Time
+}
+
+// Licensed lisansı okunabilen paket sayısı
+func (s *SBOM) Licensed() int {
+	n := 0
+	for _, c := range s.Components {
+		if c.License != "" {
+			n++
+		}
+	}
+	return n
+}
+
+// BuildSBOM collects every package version resolved in the project's lockfiles
+// (package-lock.json, pnpm-lock.yaml, yarn.lock) and go.sum files under the root,
+// frontend and backend directories. Licenses are read offline from installed
+// metadata: node_modules for JS and the Go module cache for Go.
+func (d *Doctor) BuildSBOM(p *domain.Project) (*SBOM, error) {
+	sbom := &SBOM{Project: p.Name, Created: time.Now().UTC()}
+	seen := make(map[string]bool)
+	add := func(c SBOMComponent) {
+		if !seen[c.PURL] {
+			seen[c.PURL] = true
+			sbom.Components = append(sbom.Components, c)
+		}
+	}
+	source := func(path string) {
+		rel, err := filepath.Rel(p.Path, path)
+		if err != nil || strings.HasPrefix(rel, "..") {
+			rel = path
+		}
+		sbom.Sources = append(sbom.Sources, filepath.ToSlash(rel))
+	}
+
+	checked := 0
+	done := make(map[string]bool) // Aynı workspace kökü bir kez okunur
+	for _, dir := range projectDirs(p) {
+		if pathExists(filepath.Join(dir, "package.json")) {
+			pm := ResolvePackageManager(dir)
+			if !done[pm.Root] {
+				done[pm.Root] = true
+				checked++
+				if sbom.Version == "" {
+					sbom.Version = packageVersion(dir)
+				}
+				if pm.Lockfile == "" {
+					sbom.Errors = append(sbom.Errors, fmt.Sprintf("%s: kilit dosyası yok (%s çalıştırın)", pm.Name, commandLine(pm.Install())))
+				} else if pkgs, err := lockedPackages(filepath.Join(pm.Root, pm.Lockfile)); err != nil {
+					sbom.Errors = append(sbom.Errors, pm.Lockfile+": "+err.Error())
+				} else {
+					source(filepath.Join(pm.Root, pm.Lockfile))
+					for _, pkg := range pkgs {
+						add(SBOMComponent{
+							Ecosystem: "npm", Name: pkg.name, Version: pkg.version,
+							PURL:    npmPURL(pkg.name, pkg.version),
+							License: npmLicense(pm.Root, pkg.name, pkg.version),
+						})
+					}
+				}
+			}
+		}
+		if pathExists(filepath.Join(dir, "go.mod")) {
+			checked++
+			mods, err := goSumModules(filepath.Join(dir, "go.sum"))
+			if err != nil {
+				sbom.Errors = append(sbom.Errors, "go.sum: "+err.Error())
+				continue
+			}
+			source(filepath.Join(dir, "go.sum"))
+			for _, mod := range mods {
+				add(SBOMComponent{
+					Ecosystem: "golang", Name: mod.name, Version: mod.version,
+					PURL:    "pkg:golang/" + purlPath(mod.name) + "@" + purlEscape(mod.version),
+					License: goLicense(mod.name, mod.version),
+				})
+			}
+		}
+	}
+	if checked == 0 {
+		return nil, fmt.Errorf("SBOM için kilit dosyası okunabilecek bir manifest bulunamadı (package.json, go.mod)")
+	}
+	if len(sbom.Sources) == 0 {
+		return nil, fmt.Errorf("%s", sbom.Errors[0])
+	}
+
+	sort.Slice(sbom.Components, func(i, j int) bool {
+		a, b := sbom.Components[i], sbom.Components[j]
+		if a.Ecosystem != b.Ecosystem {
+			return a.Ecosystem > b.Ecosystem // npm, sonra golang
+		}
+		if a.Name != b.Name {
+			return a.Name < b.Name
+		}
+		return a.Version < b.Version
+	})
+	return sbom, nil
+}
+
+// packageVersion package.json'daki "version" alanı
+func packageVersion(dir string) string {
+	data, err := os.ReadFile(filepath.Join(dir, "package.json"))
+	if err != nil {
+		return ""
+	}
+	var pkg struct {
+		Version string `json:"version"`
+	}
+	_ = json.Unmarshal(data, &pkg)
+	return pkg.Version
+}
+
+// purlEscape purl segmentini kaçışlar; url.PathEscape "@" karakterini olduğu gibi bırakır
+func purlEscape(s string) string {
+	return strings.ReplaceAll(url.PathEscape(s), "@", "%40")
+}
+
+// purlPath purl içindeki ad segmentlerini kaçışlar ("@scope" -> "%40scope"), "/" ayraçları korunur
+func purlPath(name string) string {
+	parts := strings.Split(name, "/")
+	for i, p := range parts {
+		parts[i] = purlEscape(p)
+	}
+	return strings.Join(parts, "/")
+}
+
+// npmPURL "@scope/name" paketleri için namespace'i ayrı tutar: pkg:npm/%40scope/name@1.0.0
+func npmPURL(name, version string) string {
+	return "pkg:npm/" + purlPath(name) + "@" + purlEscape(version)
+}
+
+// npmLicense paketin kurulu package.json'ından lisansı okur. Hoist edilmiş node_modules
+// ve pnpm deposu (.pnpm) denenir; kurulu sürüm kilitli sürümden farklıysa kullanılmaz.
+func npmLicense(root, name, version string) string {
+	candidates := []string{
+		filepath.Join(root, "node_modules", filepath.FromSlash(name), "package.json"),
+		filepath.Join(root, "node_modules", ".pnpm", strings.ReplaceAll(name, "/", "+")+"@"+version, "node_modules", filepath.FromSlash(name), "package.json"),
+	}
+	for _, path := range candidates {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		var pkg struct {
+			Version  string          `json:"version"`
+			License  json.RawMessage `json:"license"`
+			Licenses []struct {
+				Type string `json:"type"`
+			} `json:"licenses"`
+		}
+		if json.Unmarshal(data, &pkg) != nil || pkg.Version != version {
+			continue
+		}
+		// "MIT" veya eski biçim {"type": "MIT"}
+		var license string
+		if json.Unmarshal(pkg.License, &license) != nil {
+			var typed struct {
+				Type string `json:"type"`
+			}
+			_ = json.Unmarshal(pkg.License, &typed)
+			license = typed.Type
+		}
+		if license == "" && len(pkg.Licenses) > 0 {
+			var types []string
+			for _, l := range pkg.Licenses {
+				types = append(types, l.Type)
+			}
+			license = strings.Join(types, " OR ")
+			if len(types) > 1 {
+				license = "(" + license + ")"
+			}
+		}
+		return strings.TrimSpace(license)
+	}
+	return ""
+}
+
+// goModCache GOMODCACHE, yoksa GOPATH/pkg/mod, yoksa ~/go/pkg/mod (go komutu çalıştırılmaz)
+func goModCache() string {
+	if dir := os.Getenv("GOMODCACHE"); dir != "" {
+		return dir
+	}
+	if gopath := os.Getenv("GOPATH"); gopath != "" {
+		return filepath.Join(filepath.SplitList(gopath)[0], "pkg", "mod")
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return ""
+	}
+	return filepath.Join(home, "go", "pkg", "mod")
+}
+
+// goEscapePath modül önbelleğindeki klasör adını üretir: büyük harfler "!" + küçük harf olur
+func goEscapePath(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if unicode.IsUpper(r) {
+			b.WriteByte('!')
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// goLicense modül önbelleğindeki LICENSE dosyasından lisansı tanır
+func goLicense(path, version string) string {
+	cache := goModCache()
+	if cache == "" {
+		return ""
+	}
+	dir := filepath.Join(cache, filepath.FromSlash(goEscapePath(path))+"@"+goEscapePath(version))
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return ""
+	}
+	for _, e := range entries {
+		name := strings.ToUpper(e.Name())
+		if e.IsDir() || !(strings.HasPrefix(name, "LICENSE") || strings.HasPrefix(name, "LICENCE") || strings.HasPrefix(name, "COPYING")) {
+			continue
+		}
+		data, err := os.ReadFile(filepath.Join(dir, e.Name()))
+		if err != nil {
+			continue
+		}
+		if id := detectLicense(string(data)); id != "" {
+			return id
+		}
+	}
+	return ""
+}
+
+// licenseTexts lisans metninde aranan ayırt edici cümleler; daha özel olanlar önce
+var licenseTexts = []struct {
+	id      string
+	phrases []string
+}{
+	{"AGPL-3.0", []string{"GNU AFFERO GENERAL PUBLIC LICENSE", "Version 3"}},
+	{"LGPL-3.0", []string{"GNU LESSER GENERAL PUBLIC LICENSE", "Version 3"}},
+	{"LGPL-2.1", []string{"GNU LESSER GENERAL PUBLIC LICENSE", "Version 2.1"}},
+	{"GPL-3.0", []string{"GNU GENERAL PUBLIC LICENSE", "Version 3"}},
+	{"GPL-2.0", []string{"GNU GENERAL PUBLIC LICENSE", "Version 2"}},
+	{"MPL-2.0", []string{"Mozilla Public License", "2.0"}},
+	{"Apache-2.0", []string{"Apache License", "Version 2.0"}},
+	{"BSD-3-Clause", []string{"Redistribution and use in source and binary forms", "Neither the name"}},
+	{"BSD-2-Clause", []string{"Redistribution and use in source and binary forms"}},
+	{"ISC", []string{"Permission to use, copy, modify, and/or distribute this software for any"}},
+	{"MIT", []string{"Permission is hereby granted, free of charge"}},
+	{"Unlicense", []string{"This is free and unencumbered software released into the public domain"}},
+	{"CC0-1.0", []string{"CC0 1.0 Universal"}},
+}
+
+var spaceRe = regexp.MustCompile(`\s+`)
+
+// detectLicense lisans metnini SPDX kimliğine eşler (tanınmazsa boş)
+func detectLicense(text string) string {
+	text = spaceRe.ReplaceAllString(text, " ")
+	for _, l := range licenseTexts {
+		match := true
+		for _, phrase := range l.phrases {
+			if !strings.Contains(text, phrase) {
+				match = false
+				break
+			}
+		}
+		if match {
+			return l.id
+		}
+	}
+	return ""
+}
+
+// spdxLicense lisansı SPDX ifade sözdizimine göre doğrular ve bilinen kimlikleri kanonik
+// yazımla döndürür ("mit" -> "MIT", "(MIT OR Apache-2.0)"). Listede olmayan ama sözdizimi
+// geçerli kimlikler olduğu gibi kalır; "SEE LICENSE IN ...", "Public Domain" gibi ifade
+// olmayan değerler için false döner ve çağıran LicenseRef üretir.
+func spdxLicense(license string) (string, bool) {
+	license = strings.TrimSpace(license)
+	if license == "" || license == "UNLICENSED" {
+		return "", false
+	}
+	tokens := strings.Fields(strings.NewReplacer("(", " ( ", ")", " ) ").Replace(license))
+	out := make([]string, 0, len(tokens))
+	depth, expectID, afterWith := 0, true, false
+	for _, tok := range tokens {
+		switch {
+		case tok == "(":
+			if !expectID {
+				return "", false
+			}
+			depth++
+		case tok == ")":
+			if expectID || depth == 0 {
+				return "", false
+			}
+			depth--
+		case strings.EqualFold(tok, "AND") || strings.EqualFold(tok, "OR") || strings.EqualFold(tok, "WITH"):
+			if expectID {
+				return "", false
+			}
+			tok = strings.ToUpper(tok)
+			expectID, afterWith = true, tok == "WITH"
+		default:
+			if !expectID {
+				return "", false
+			}
+			index := spdxLicenseIndex
+			if afterWith {
+				index = spdxExceptionIndex
+			}
+			plus := !afterWith && strings.HasSuffix(tok, "+")
+			id := strings.TrimSuffix(tok, "+")
+			if !spdxIDRe.MatchString(id) {
+				return "", false
+			}
+			if known, ok := index[strings.ToLower(id)]; ok {
+				id = known
+			}
+			if plus {
+				id += "+"
+			}
+			tok, expectID, afterWith = id, false, false
+		}
+		out = append(out, tok)
+	}
+	if expectID || depth != 0 {
+		return "", false
+	}
+	return strings.NewReplacer("( ", "(", " )", ")").Replace(strings.Join(out, " ")), true
+}
+
+// spdxLicenseRef SPDX listesinde olmayan bir lisans için "LicenseRef-" kimliği üretir
+func spdxLicenseRef(license string) string {
+	ref := strings.Trim(licenseRefRe.ReplaceAllString(license, "-"), "-")
+	if ref == "" {
+		ref = "unknown"
+	}
+	return "LicenseRef-" + ref
+}
+
+var (
+	licenseRefRe = regexp.MustCompile(`[^A-Za-z0-9.]+`)
+	// SPDX idstring; DocumentRef'ler belgede tanımlı olmadığından kabul edilmez
+	spdxIDRe = regexp.MustCompile(`^[A-Za-z0-9.-]+$`)
+)
+
+// newUUID rastgele (v4) UUID üretir
+func newUUID() string {
+	var b [16]byte
+	_, _ = rand.Read(b[:])
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// WriteCycloneDX SBOM'u CycloneDX 1.5 JSON olarak yazar
+func (s *SBOM) WriteCycloneDX(w io.Writer) error {
+	type licenseName struct {
+		Name string `json:"name"`
+	}
+	type license struct {
+		License    *licenseName `json:"license,omitempty"`
+		Expression string       `json:"expression,omitempty"`
+	}
+	type component struct {
+		Type     string    `json:"type"`
+		BOMRef   string    `json:"bom-ref"`
+		Group    string    `json:"group,omitempty"`
+		Name     string    `json:"name"`
+		Version  string    `json:"version,omitempty"`
+		PURL     string    `json:"purl,omitempty"`
+		Licenses []license `json:"licenses,omitempty"`
+	}
+
+	root := component{Type: "application", BOMRef: "root", Name: s.Project, Version: s.Version}
+	components := make([]component, 0, len(s.Components))
+	for _, c := range s.Components {
+		comp := component{Type: "library", BOMRef: c.PURL, Name: c.Name, Version: c.Version, PURL: c.PURL}
+		// npm: "@scope/name" -> group "@scope", name "name"
+		if c.Ecosystem == "npm" && strings.HasPrefix(c.Name, "@") {
+			comp.Group, comp.Name, _ = strings.Cut(c.Name, "/")
+		}
+		if expr, ok := spdxLicense(c.License); ok {
+			comp.Licenses = []license{{Expression: expr}}
+		} else if c.License != "" {
+			comp.Licenses = []license{{License: &licenseName{c.License}}}
+		}
+		components = append(components, comp)
+	}
+
+	doc := map[string]any{
+		"bomFormat":    "CycloneDX",
+		"specVersion":  "1.5",
+		"serialNumber": "urn:uuid:" + newUUID(),
+		"version":      1,
+		"metadata": map[string]any{
+			"timestamp": s.Created.Format(time.RFC3339),
+			"tools": map[string]any{
+				"components": []map[string]string{{"type": "application", "name": "devterminal"}},
+			},
+			"component": root,
+		},
+		"components": components,
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(doc)
+}
+
+// extractedLicense SPDX belgesinde tanımlanan, listede olmayan bir lisanstır
+type extractedLicense struct {
+	ID   string `json:"licenseId"`
+	Name string `json:"name"`
+	Text string `json:"extractedText"`
+}
+
+// refIDTaken LicenseRef kimliği daha önce başka bir lisansa verilmiş mi
+func refIDTaken(extracted []extractedLicense, id string) bool {
+	for _, e := range extracted {
+		if e.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// WriteSPDX SBOM'u SPDX 2.3 JSON olarak yazar; kök paket tüm bileşenlere DEPENDS_ON ile bağlanır
+func (s *SBOM) WriteSPDX(w io.Writer) error {
+	type externalRef struct {
+		Category string `json:"referenceCategory"`
+		Type     string `json:"referenceType"`
+		Locator  string `json:"referenceLocator"`
+	}
+	type pkg struct {
+		Name             string        `json:"name"`
+		SPDXID           string        `json:"SPDXID"`
+		VersionInfo      string        `json:"versionInfo,omitempty"`
+		DownloadLocation string        `json:"downloadLocation"`
+		FilesAnalyzed    bool          `json:"filesAnalyzed"`
+		LicenseConcluded string        `json:"licenseConcluded"`
+		LicenseDeclared  string        `json:"licenseDeclared"`
+		ExternalRefs     []externalRef `json:"externalRefs,omitempty"`
+	}
+	type relationship struct {
+		Element string `json:"spdxElementId"`
+		Type    string `json:"relationshipType"`
+		Related string `json:"relatedSpdxElement"`
+	}
+
+	packages := []pkg{{
+		Name: s.Project, SPDXID: "SPDXRef-Package-root", VersionInfo: s.Version,
+		DownloadLocation: "NOASSERTION", LicenseConcluded: "NOASSERTION", LicenseDeclared: "NOASSERTION",
+	}}
+	relationships := []relationship{{"SPDXRef-DOCUMENT", "DESCRIBES", "SPDXRef-Package-root"}}
+	// SPDX listesinde olmayan lisanslar LicenseRef olarak belgede tanımlanır
+	var extracted []extractedLicense
+	refs := make(map[string]string)
+	for i, c := range s.Components {
+		id := fmt.Sprintf("SPDXRef-Package-%s-%d", c.Ecosystem, i+1)
+		declared := "NOASSERTION"
+		if expr, ok := spdxLicense(c.License); ok {
+			declared = expr
+		} else if c.License != "" && c.License != "UNLICENSED" {
+			ref, ok := refs[c.License]
+			if !ok {
+				ref = spdxLicenseRef(c.License)
+				for n := 2; refIDTaken(extracted, ref); n++ {
+					ref = fmt.Sprintf("%s-%d", spdxLicenseRef(c.License), n)
+				}
+				refs[c.License] = ref
+				extracted = append(extracted, extractedLicense{ID: ref, Name: c.License, Text: c.License})
+			}
+			declared = ref
+		}
+		packages = append(packages, pkg{
+			Name: c.Name, SPDXID: id, VersionInfo: c.Version,
+			DownloadLocation: "NOASSERTION", LicenseConcluded: "NOASSERTION", LicenseDeclared: declared,
+			ExternalRefs: []externalRef{{"PACKAGE-MANAGER", "purl", c.PURL}},
+		})
+		relationships = append(relationships, relationship{"SPDXRef-Package-root", "DEPENDS_ON", id})
+	}
+
+	name := s.Project
+	if name == "" {
+		name = "sbom"
+	}
+	doc := map[string]any{
+		"spdxVersion":       "SPDX-2.3",
+		"dataLicense":       "CC0-1.0",
+		"SPDXID":            "SPDXRef-DOCUMENT",
+		"name":              name,
+		"documentNamespace": "https://spdx.org/spdxdocs/" + url.PathEscape(name) + "-" + newUUID(),
+		"creationInfo": map[string]any{
+			"created":  s.Created.Format(time.RFC3339),
+			"creators": []string{"Tool: devterminal"},
+		},
+		"packages":      packages,
+		"relationships": relationships,
+	}
+	if len(extracted) > 0 {
+		doc["hasExtractedLicensingInfos"] = extracted
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(doc)
+}
+
+// Write SBOM'u verilen biçimde yazar
+func (s *SBOM) Write(w io.Writer, format SBOMFormat) error {
+	switch format {
+	case SBOMCycloneDX:
+		return s.WriteCycloneDX(w)
+	case SBOMSPDX:
+		return s.WriteSPDX(w)
+	}
+	return fmt.Errorf("bilinmeyen SBOM biçimi: %s (cyclonedx veya spdx)", format)
+}
+
+// SBOMFileName biçimin yaygın dosya adı uzantısıyla dosya adı (örn: "api.cdx.json", "api.spdx.json")
+func SBOMFileName(project string, format SBOMFormat) string {
+	if format == SBOMSPDX {
+		return project + ".spdx.json"
+	}
+	return project + ".cdx.json"
+}
+
+// ExportSBOM SBOM'u klasöre verilen biçimde kaydeder ve dosya yolunu döndürür
+func ExportSBOM(s *SBOM, format SBOMFormat, dir string) (string, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+	path := filepath.Join(dir, SBOMFileName(s.Project, format))
+	file, err := os.Create(path)
+	if err != nil {
+		return "", err
+	}
+	err = s.Write(file, format)
+	if cerr := file.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		return "", err
+	}
+	return path, nil
+}
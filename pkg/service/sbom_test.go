@@ -0,0 +1,58 @@
+package service
+
+import "testing"
+
+func TestSPDXLicense(t *testing.T) {
+	tests := []struct {
+		license string
+		want    string
+		ok      bool
+	}{
+		{"MIT", "MIT", true},
+		{"mit", "MIT", true},
+		{" apache-2.0 ", "Apache-2.0", true},
+		{"GPL-2.0+", "GPL-2.0+", true},
+		{"(MIT OR Apache-2.0)", "(MIT OR Apache-2.0)", true},
+		{"mit or isc", "MIT OR ISC", true},
+		{"(MIT AND (BSD-3-Clause OR Apache-2.0))", "(MIT AND (BSD-3-Clause OR Apache-2.0))", true},
+		{"GPL-2.0-only WITH classpath-exception-2.0", "GPL-2.0-only WITH Classpath-exception-2.0", true},
+		{"LicenseRef-Proprietary", "LicenseRef-Proprietary", true},
+
+		// Listede olmayan ama sözdizimi geçerli kimlikler olduğu gibi kalır
+		{"Zimbra-1.4", "Zimbra-1.4", true},
+		{"NLPL OR MIT", "NLPL OR MIT", true},
+
+		// İfade olmayan değerler
+		{"", "", false},
+		{"UNLICENSED", "", false},
+		{"SEE LICENSE IN LICENSE.md", "", false},
+		{"Public Domain", "", false},
+		{"MIT/X11", "", false},
+		{"MIT OR", "", false},
+		{"(MIT", "", false},
+		{"MIT)", "", false},
+		{"AND MIT", "", false},
+		{"MIT WITH", "", false},
+		{"DocumentRef-x:LicenseRef-y", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := spdxLicense(tt.license)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("spdxLicense(%q) = (%q, %v), beklenen (%q, %v)", tt.license, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestSPDXLicenseRef(t *testing.T) {
+	tests := map[string]string{
+		"SEE LICENSE IN LICENSE.md": "LicenseRef-SEE-LICENSE-IN-LICENSE.md",
+		"Public Domain":             "LicenseRef-Public-Domain",
+		"(c) ACME, Inc.":            "LicenseRef-c-ACME-Inc.",
+		"???":                       "LicenseRef-unknown",
+	}
+	for license, want := range tests {
+		if got := spdxLicenseRef(license); got != want {
+			t.Errorf("spdxLicenseRef(%q) = %q, beklenen %q", license, got, want)
+		}
+	}
+}
@@ -2,7 +2,6 @@ package service
 
 import (
 	"encoding/json"
-	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
@@ -186,6 +185,38 @@ func isMonorepoFolder(folderName string) bool {
 	return false
 }
 
+// monorepoFolderDirs monorepo klasörlerinin (apps/, packages/, services/) alt klasörlerini döndürür
+func monorepoFolderDirs(projectPath string) []string {
+	entries, err := os.ReadDir(projectPath)
+	if err != nil {
+		return nil
+	}
+
+	var dirs []string
+	for _, entry := range entries {
+		if !entry.IsDir() || !isMonorepoFolder(entry.Name()) {
+			continue
+		}
+		monorepoPath := filepath.Join(projectPath, entry.Name())
+		subEntries, err := os.ReadDir(monorepoPath)
+		if err != nil {
+			continue
+		}
+		for _, subEntry := range subEntries {
+			if !subEntry.IsDir() {
+				continue
+			}
+			// Skip common non-project directories
+			name := strings.ToLower(subEntry.Name())
+			if name == "node_modules" || name == ".git" || name == "dist" || name == "build" || name == ".next" {
+				continue
+			}
+			dirs = append(dirs, filepath.Join(monorepoPath, subEntry.Name()))
+		}
+	}
+	return dirs
+}
+
 // hasMonorepoStructure projenin monorepo yapısında olup olmadığını kontrol eder
 func hasMonorepoStructure(projectPath string) bool {
 	entries, err := os.ReadDir(projectPath)
@@ -199,21 +230,8 @@ func hasMonorepoStructure(projectPath string) bool {
 		}
 	}
 
-	// pnpm-workspace.yaml veya lerna.json varsa monorepo
-	if _, err := os.Stat(filepath.Join(projectPath, "pnpm-workspace.yaml")); err == nil {
-		return true
-	}
-	if _, err := os.Stat(filepath.Join(projectPath, "lerna.json")); err == nil {
-		return true
-	}
-	if _, err := os.Stat(filepath.Join(projectPath, "turbo.json")); err == nil {
-		return true
-	}
-	if _, err := os.Stat(filepath.Join(projectPath, "nx.json")); err == nil {
-		return true
-	}
-
-	return false
+	// pnpm-workspace.yaml, package.json workspaces, lerna, turbo veya nx varsa monorepo
+	return hasWorkspaceConfig(projectPath)
 }
 
 // Dosya yapısı bazlı backend sinyalleri
@@ -748,8 +766,8 @@ func getDockerBaseImage(path string) string {
 // calculateHealthScore proje sağlık skorunu hesaplar (0-100)
 func (s *Scanner) calculateHealthScore(projectPath string, p *domain.Project) {
 	// Use the unified HealthService to avoid inconsistencies
-	hs := NewHealthService()
-	report := hs.CheckHealth(projectPath)
+	hs := NewHealthService(s.Config)
+	report := hs.CheckHealth(p)
 
 	p.HealthScore = report.Score
 	p.HealthDetails = report.PassedItems
@@ -822,79 +840,57 @@ func (s *Scanner) checkCustomRules(projectPath string, p *domain.Project) {
 	}
 }
 func (s *Scanner) scanMonorepo(projectPath string, p *domain.Project) {
-	entries, err := os.ReadDir(projectPath)
-	if err != nil {
-		return
+	// Önce workspace tanımlarından (pnpm, npm/yarn, lerna, nx, turbo) gerçek listeyi al,
+	// tanım yoksa klasör adı tahminine (apps/, packages/, services/) geri dön
+	subPaths := workspaceDirs(projectPath)
+	if len(subPaths) == 0 {
+		subPaths = monorepoFolderDirs(projectPath)
 	}
 
 	frontendSigs := s.getFrontendSignatures()
 	backendSigs := s.getBackendSignatures()
 
-	for _, entry := range entries {
-		if !entry.IsDir() {
-			continue
-		}
-
-		// Monorepo klasörlerini bul (apps/, packages/, services/)
-		if isMonorepoFolder(entry.Name()) {
-			monorepoPath := filepath.Join(projectPath, entry.Name())
-			subEntries, err := os.ReadDir(monorepoPath)
-			if err != nil {
-				continue
-			}
-
-			// Her alt klasörü tara
-			for _, subEntry := range subEntries {
-				if !subEntry.IsDir() {
-					continue
+	for _, subPath := range subPaths {
+		subName := filepath.Base(subPath)
+		pkgName := workspacePackageName(subPath)
+
+		// Frontend kontrolü
+		for _, sig := range frontendSigs {
+			if found, ver := sig.CheckFunc(subPath); found {
+				subProject := domain.SubProject{
+					Name:        subName,
+					PackageName: pkgName,
+					Path:        subPath,
+					Type:        sig.Type,
+					Version:     ver,
+					StartCmd:    s.detectStartCommand(subPath, true, false),
+					IsFrontend:  true,
 				}
-
-				subPath := filepath.Join(monorepoPath, subEntry.Name())
-				subName := subEntry.Name()
-
-				// Skip common non-project directories
-				name := strings.ToLower(subName)
-				if name == "node_modules" || name == ".git" || name == "dist" || name == "build" || name == ".next" {
-					continue
+				if subProject.Version == "" {
+					subProject.Version = "Var"
 				}
+				p.AllFrontends = append(p.AllFrontends, subProject)
+				break
+			}
+		}
 
-				// Frontend kontrolü
-				for _, sig := range frontendSigs {
-					if found, ver := sig.CheckFunc(subPath); found {
-						subProject := domain.SubProject{
-							Name:       subName,
-							Path:       subPath,
-							Type:       sig.Type,
-							Version:    ver,
-							StartCmd:   s.detectStartCommand(subPath, true, false),
-							IsFrontend: true,
-						}
-						if subProject.Version == "" {
-							subProject.Version = "Var"
-						}
-						p.AllFrontends = append(p.AllFrontends, subProject)
-						break
-					}
+		// Backend kontrolü
+		for _, sig := range backendSigs {
+			if found, ver := sig.CheckFunc(subPath); found {
+				subProject := domain.SubProject{
+					Name:        subName,
+					PackageName: pkgName,
+					Path:        subPath,
+					Type:        sig.Type,
+					Version:     ver,
+					StartCmd:    s.detectStartCommand(subPath, false, true),
+					IsFrontend:  false,
 				}
-
-				// Backend kontrolü
-				for _, sig := range backendSigs {
-					if found, ver := sig.CheckFunc(subPath); found {
-						subProject := domain.SubProject{
-							Name:       subName,
-							Path:       subPath,
-							Type:       sig.Type,
-							Version:    ver,
-							StartCmd:   s.detectStartCommand(subPath, false, true),
-							IsFrontend: false,
-						}
-						if subProject.Version == "" {
-							subProject.Version = "Var"
-						}
-						p.AllBackends = append(p.AllBackends, subProject)
-						break
-					}
+				if subProject.Version == "" {
+					subProject.Version = "Var"
 				}
+				p.AllBackends = append(p.AllBackends, subProject)
+				break
 			}
 		}
 	}
@@ -933,8 +929,9 @@ func (s *Scanner) ScanProjects() []domain.Project {
 		for i := range projects {
 			s.calculateHealthScore(projects[i].Path, &projects[i])
 			s.checkTools(projects[i].Path, &projects[i])
-			// Scriptleri her zaman taze tut
-			projects[i].Scripts = s.scanPackageScripts(&projects[i])
+			// Scriptleri ve manifesti her zaman taze tut
+			projects[i].Tasks = s.scanTasks(&projects[i])
+			s.loadServices(&projects[i])
 		}
 
 		// Cache'den gelen projeler için de config senkronizasyonu yap
@@ -1295,71 +1292,96 @@ func (s *Scanner) scanDirectory(root string) []domain.Project {
 		}
 		seen[fullPath] = true
 
-		p := domain.Project{
-			Name: entry.Name(),
-			Path: fullPath,
-			Type: domain.TypeUnknown,
+		if p, ok := s.ScanProject(fullPath); ok {
+			results = append(results, p)
 		}
+	}
+	return results
+}
 
-		// ========================================
-		// ADIM 1: Alt klasörleri tara (Signature-Based)
-		// ========================================
-		s.scanSubdirectories(fullPath, &p)
+// ScanProject klasörü proje listesindeki gibi tarar (alt klasörler, monorepo, özel kurallar, görevler).
+// Proje olarak algılanmazsa ok=false döner.
+func (s *Scanner) ScanProject(fullPath string) (domain.Project, bool) {
+	p := domain.Project{
+		Name: filepath.Base(fullPath),
+		Path: fullPath,
+		Type: domain.TypeUnknown,
+	}
 
-		// ========================================
-		// ADIM 2: Monorepo kontrolü
-		// ========================================
-		if hasMonorepoStructure(fullPath) {
-			s.scanMonorepo(fullPath, &p)
-		}
+	// ========================================
+	// ADIM 1: Alt klasörleri tara (Signature-Based)
+	// ========================================
+	s.scanSubdirectories(fullPath, &p)
 
-		// ========================================
-		// ADIM 3: Root dizini tara (Monorepo olmayan projeler)
-		// ========================================
-		if !p.HasFrontend && !p.HasBackend {
-			s.scanRootDirectory(fullPath, &p)
-		}
+	// ========================================
+	// ADIM 2: Monorepo kontrolü
+	// ========================================
+	if hasMonorepoStructure(fullPath) {
+		s.scanMonorepo(fullPath, &p)
+	}
 
-		// ========================================
-		// ADIM 4: Custom Rule Kontrolü
-		// ========================================
-		s.checkCustomRules(fullPath, &p)
-
-		// ========================================
-		// ADIM 5: Tip Belirleme
-		// ========================================
-		s.determineProjectType(&p)
-
-		// ========================================
-		// ADIM 6: Sadece proje olarak algılananları ekle
-		// ========================================
-		isProject := p.HasFrontend || p.HasBackend || p.HasDocker ||
-			p.Type != domain.TypeUnknown ||
-			p.FrontendType != "" || p.BackendType != ""
-
-		if isProject {
-			// Sağlık skoru hesapla
-			s.calculateHealthScore(fullPath, &p)
-
-			// Araç kontrolü (Prisma, Drizzle, vb.)
-			s.checkTools(fullPath, &p)
-
-			// Yerel config kalıntısı temizliği yapılabilir ama şimdilik gerek yok
-			// s.manageProjectConfig(&p) kaldırıldı.
-
-			// Port kontrolü yap
-			portInfos := CheckProjectPorts(p.HasFrontend, p.HasBackend)
-			for _, info := range portInfos {
-				p.PortWarnings = append(p.PortWarnings, FormatPortWarning(info))
-			}
+	// ========================================
+	// ADIM 3: Root dizini tara (Monorepo olmayan projeler)
+	// ========================================
+	if !p.HasFrontend && !p.HasBackend {
+		s.scanRootDirectory(fullPath, &p)
+	}
 
-			// Package Scripts taraması
-			p.Scripts = s.scanPackageScripts(&p)
+	// ========================================
+	// ADIM 4: Custom Rule Kontrolü
+	// ========================================
+	s.checkCustomRules(fullPath, &p)
 
-			results = append(results, p)
-		}
+	// ========================================
+	// ADIM 5: Tip Belirleme
+	// ========================================
+	s.determineProjectType(&p)
+
+	// ========================================
+	// ADIM 5.5: Servis manifesti (.devterminal.yaml)
+	// ========================================
+	s.loadServices(&p)
+
+	// ========================================
+	// ADIM 6: Sadece proje olarak algılananları ekle
+	// ========================================
+	isProject := p.HasFrontend || p.HasBackend || p.HasDocker ||
+		p.Type != domain.TypeUnknown ||
+		p.FrontendType != "" || p.BackendType != "" ||
+		len(p.Services) > 0 || p.ManifestError != ""
+
+	if !isProject {
+		return p, false
+	}
+
+	// Sağlık skoru hesapla
+	s.calculateHealthScore(fullPath, &p)
+
+	// Araç kontrolü (Prisma, Drizzle, vb.)
+	s.checkTools(fullPath, &p)
+
+	// Yerel config kalıntısı temizliği yapılabilir ama şimdilik gerek yok
+	// s.manageProjectConfig(&p) kaldırıldı.
+
+	// Port kontrolü yap
+	portInfos := CheckProjectPorts(p.HasFrontend, p.HasBackend)
+	for _, info := range portInfos {
+		p.PortWarnings = append(p.PortWarnings, FormatPortWarning(info))
+	}
+
+	// Görev taraması (package.json, Makefile, justfile...)
+	p.Tasks = s.scanTasks(&p)
+	return p, true
+}
+
+// loadServices proje kökündeki servis manifestini okur
+func (s *Scanner) loadServices(p *domain.Project) {
+	services, err := LoadManifest(p.Path)
+	p.Services = services
+	p.ManifestError = ""
+	if err != nil {
+		p.ManifestError = err.Error()
 	}
-	return results
 }
 
 // scanSubdirectories scans all immediate subdirectories for tech signatures
@@ -1624,20 +1646,6 @@ func (s *Scanner) getPackageVersion(path, pkgName string) string {
 	return ""
 }
 
-// detectPackageManager projenin kullandığı paket yöneticisini tespit eder
-func (s *Scanner) detectPackageManager(path string) string {
-	if _, err := os.Stat(filepath.Join(path, "bun.lockb")); err == nil {
-		return "bun"
-	}
-	if _, err := os.Stat(filepath.Join(path, "pnpm-lock.yaml")); err == nil {
-		return "pnpm"
-	}
-	if _, err := os.Stat(filepath.Join(path, "yarn.lock")); err == nil {
-		return "yarn"
-	}
-	return "npm"
-}
-
 // detectStartCommand determines the best command to start the project
 func (s *Scanner) detectStartCommand(path string, isFrontend, isBackend bool) string {
 	// 1. JS/TS Projects (Next, Nest, React, Vue, etc.)
@@ -1647,11 +1655,7 @@ func (s *Scanner) detectStartCommand(path string, isFrontend, isBackend bool) st
 		if err == nil {
 			var pkg packageJSON
 			if err := json.Unmarshal(data, &pkg); err == nil {
-				pm := s.detectPackageManager(path)
-				runCmd := pm + " run"
-				if pm == "bun" {
-					runCmd = "bun run"
-				}
+				pm := ResolvePackageManager(path)
 
 				// Akıllı Script Analizi (Score-Based)
 				bestScript := ""
@@ -1752,10 +1756,10 @@ func (s *Scanner) detectStartCommand(path string, isFrontend, isBackend bool) st
 
 				if bestScript != "" && maxScore > 0 {
 					// "npm start" özel durumu
-					if bestScript == "start" && pm == "npm" {
+					if bestScript == "start" && pm.Name == "npm" {
 						return "npm start"
 					}
-					return fmt.Sprintf("%s %s", runCmd, bestScript)
+					return commandLine(pm.Run(bestScript))
 				}
 			}
 		}
@@ -1867,166 +1871,6 @@ func (s *Scanner) syncProjectsWithConfig(projects []domain.Project) bool {
 	return modified
 }
 
-// checkTools checks for various tools (Prisma, Drizzle, Hasura, Supabase, Storybook)
-func (s *Scanner) checkTools(path string, p *domain.Project) {
-	// Temizle
-	p.PrismaPath = ""
-	p.DrizzlePath = ""
-	p.HasuraPath = ""
-	p.SupabasePath = ""
-	p.StorybookPath = ""
-
-	_ = filepath.WalkDir(path, func(fPath string, d os.DirEntry, err error) error {
-		if err != nil {
-			return nil
-		}
-
-		name := d.Name()
-
-		// Skip heavy directories
-		if d.IsDir() && fPath != path {
-			if name == "node_modules" || name == ".git" || name == "vendor" || name == "dist" || name == "build" || name == ".next" {
-				return filepath.SkipDir
-			}
-			// Skip hidden folders except specific tool folders
-			if strings.HasPrefix(name, ".") {
-				if name != ".config" && name != ".storybook" && name != ".hasura" {
-					return filepath.SkipDir
-				}
-			}
-		}
-
-		// Depth check (max 3 levels)
-		rel, _ := filepath.Rel(path, fPath)
-		depth := strings.Count(rel, string(os.PathSeparator))
-		if depth > 3 {
-			if d.IsDir() {
-				return filepath.SkipDir
-			}
-			return nil
-		}
-
-		dir := filepath.Dir(fPath)
-
-		// --- Check Dirs ---
-		if d.IsDir() {
-			if name == ".storybook" && p.StorybookPath == "" {
-				p.StorybookPath = dir // .storybook folder is inside the project root usually
-				p.HasStorybook = true
-			}
-			if name == "supabase" && p.SupabasePath == "" {
-				p.SupabasePath = dir // run npx from parent of supabase folder
-				p.HasSupabase = true
-			}
-			if name == "hasura" && p.HasuraPath == "" {
-				p.HasuraPath = dir // run hasura console from parent
-				p.HasHasura = true
-			}
-		}
-
-		// --- Check Files ---
-		if !d.IsDir() {
-			// Prisma
-			if name == "schema.prisma" && p.PrismaPath == "" {
-				// If schema is in prisma/schema.prisma, use parent folder
-				if filepath.Base(dir) == "prisma" {
-					p.PrismaPath = filepath.Dir(dir)
-				} else {
-					p.PrismaPath = dir
-				}
-				p.HasPrisma = true
-			}
-			// Drizzle
-			if strings.HasPrefix(name, "drizzle.config") && p.DrizzlePath == "" {
-				p.DrizzlePath = dir
-				p.HasDrizzle = true
-			}
-			// Package.json dependencies
-			if name == "package.json" {
-				data, err := os.ReadFile(fPath)
-				if err == nil {
-					var pkg packageJSON
-					if json.Unmarshal(data, &pkg) == nil {
-						// Check Deps
-						for dep := range pkg.Dependencies {
-							if strings.Contains(dep, "prisma") && p.PrismaPath == "" {
-								p.PrismaPath = dir
-								p.HasPrisma = true
-							}
-							if strings.Contains(dep, "drizzle-orm") && p.DrizzlePath == "" {
-								p.DrizzlePath = dir
-								p.HasDrizzle = true
-							}
-						}
-						// Check DevDeps
-						for dep := range pkg.DevDependencies {
-							if strings.Contains(dep, "prisma") && p.PrismaPath == "" {
-								p.PrismaPath = dir
-								p.HasPrisma = true
-							}
-							if strings.Contains(dep, "drizzle-orm") && p.DrizzlePath == "" {
-								p.DrizzlePath = dir
-								p.HasDrizzle = true
-							}
-							if strings.Contains(dep, "storybook") && p.StorybookPath == "" {
-								p.StorybookPath = dir
-								p.HasStorybook = true
-							}
-						}
-					}
-				}
-			}
-		}
-		return nil
-	})
-}
-
-// scanPackageScripts reads scripts from package.json
-// scanPackageScripts reads scripts from package.json in root, frontend, and backend paths
-func (s *Scanner) scanPackageScripts(p *domain.Project) map[string]string {
-	scripts := make(map[string]string)
-
-	// Helper to scan a specific path
-	scan := func(path string, prefix string) {
-		if path == "" {
-			return
-		}
-		pkgPath := filepath.Join(path, "package.json")
-		data, err := os.ReadFile(pkgPath)
-		if err != nil {
-			return
-		}
-
-		var pkg packageJSON
-		if err := json.Unmarshal(data, &pkg); err != nil {
-			return
-		}
-
-		for k, v := range pkg.Scripts {
-			key := k
-			if prefix != "" {
-				key = fmt.Sprintf("%s:%s", prefix, k)
-			}
-			scripts[key] = v
-		}
-	}
-
-	// 1. Root
-	scan(p.Path, "")
-
-	// 2. Frontend (only if different from root)
-	if p.FrontendPath != "" && p.FrontendPath != p.Path {
-		scan(p.FrontendPath, "client")
-	}
-
-	// 3. Backend (only if different from root)
-	if p.BackendPath != "" && p.BackendPath != p.Path {
-		scan(p.BackendPath, "server")
-	}
-
-	return scripts
-}
-
 func min(a, b int) int {
 	if a < b {
 		return a
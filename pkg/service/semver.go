@@ -0,0 +1,365 @@
+package service
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"devterminal/pkg/domain"
+)
+
+// Semver npm kurallarıyla karşılaştırılabilen semantik sürümdür (1.2.3-beta.1+build)
+type Semver struct {
+	Major, Minor, Patch int
+	Pre                 []string // Ön sürüm tanımlayıcıları (örn: ["beta", "1"])
+}
+
+// semverRe başta "v" / "=" kabul eder, build metadata'sını yok sayar
+var semverRe = regexp.MustCompile(`^[v=]?\s*(\d+)\.(\d+)\.(\d+)(?:-([0-9A-Za-z.-]+))?(?:\+[0-9A-Za-z.-]+)?$`)
+
+// ParseSemver "1.2.3", "v1.2.3-rc.1" gibi tam bir sürümü okur
+func ParseSemver(s string) (Semver, bool) {
+	m := semverRe.FindStringSubmatch(strings.TrimSpace(s))
+	if m == nil {
+		return Semver{}, false
+	}
+	v := Semver{}
+	v.Major, _ = strconv.Atoi(m[1])
+	v.Minor, _ = strconv.Atoi(m[2])
+	v.Patch, _ = strconv.Atoi(m[3])
+	if m[4] != "" {
+		v.Pre = strings.Split(m[4], ".")
+	}
+	return v, true
+}
+
+// isSemver metin tam bir semver sürümü mü
+func isSemver(s string) bool {
+	_, ok := ParseSemver(s)
+	return ok
+}
+
+func (v Semver) String() string {
+	s := fmt.Sprintf("%d.%d.%d", v.Major, v.Minor, v.Patch)
+	if len(v.Pre) > 0 {
+		s += "-" + strings.Join(v.Pre, ".")
+	}
+	return s
+}
+
+// Compare v < o ise -1, eşitse 0, büyükse 1 döndürür (ön sürüm < normal sürüm)
+func (v Semver) Compare(o Semver) int {
+	for _, d := range []int{v.Major - o.Major, v.Minor - o.Minor, v.Patch - o.Patch} {
+		if d != 0 {
+			return sign(d)
+		}
+	}
+	switch {
+	case len(v.Pre) == 0 && len(o.Pre) == 0:
+		return 0
+	case len(v.Pre) == 0:
+		return 1
+	case len(o.Pre) == 0:
+		return -1
+	}
+	for i := 0; i < len(v.Pre) && i < len(o.Pre); i++ {
+		a, b := v.Pre[i], o.Pre[i]
+		if a == b {
+			continue
+		}
+		an, aErr := strconv.Atoi(a)
+		bn, bErr := strconv.Atoi(b)
+		switch {
+		case aErr == nil && bErr == nil:
+			return sign(an - bn)
+		case aErr == nil: // Sayısal tanımlayıcı metinden küçüktür
+			return -1
+		case bErr == nil:
+			return 1
+		case a < b:
+			return -1
+		default:
+			return 1
+		}
+	}
+	return sign(len(v.Pre) - len(o.Pre))
+}
+
+func sign(n int) int {
+	switch {
+	case n < 0:
+		return -1
+	case n > 0:
+		return 1
+	}
+	return 0
+}
+
+// sameTuple major.minor.patch aynı mı
+func (v Semver) sameTuple(o Semver) bool {
+	return v.Major == o.Major && v.Minor == o.Minor && v.Patch == o.Patch
+}
+
+// ClassifyUpdate mevcut sürümden hedef sürüme geçişi semver'e göre sınıflandırır.
+// 1.0 öncesinde ilk sıfır olmayan parça major gibi davranır (^0.2 -> 0.3 kırıcıdır).
+func ClassifyUpdate(current, target string) domain.UpdateKind {
+	c, ok := ParseSemver(current)
+	t, ok2 := ParseSemver(target)
+	if !ok || !ok2 || t.Compare(c) <= 0 {
+		return domain.UpdateUnknown
+	}
+	if len(t.Pre) > 0 {
+		return domain.UpdatePrerelease
+	}
+	switch {
+	case t.Major != c.Major:
+		return domain.UpdateMajor
+	case t.Minor != c.Minor:
+		if c.Major == 0 {
+			return domain.UpdateMajor
+		}
+		return domain.UpdateMinor
+	case t.Patch != c.Patch && c.Major == 0 && c.Minor == 0:
+		return domain.UpdateMajor
+	}
+	// Patch değişikliği veya ön sürümden aynı sürümün kararlısına geçiş
+	return domain.UpdatePatch
+}
+
+// SemverRange npm aralığıdır ("^1.2.0", "~1.2 || >=2.1.0 <3", "1.x", "1.2.3 - 2")
+type SemverRange struct {
+	sets [][]comparator // "||" ile ayrılan kümeler; küme içindeki koşulların hepsi sağlanmalı
+}
+
+type comparator struct {
+	op string // <, <=, >, >=, =
+	v  Semver
+}
+
+func (c comparator) test(v Semver) bool {
+	n := v.Compare(c.v)
+	switch c.op {
+	case "<":
+		return n < 0
+	case "<=":
+		return n <= 0
+	case ">":
+		return n > 0
+	case ">=":
+		return n >= 0
+	}
+	return n == 0
+}
+
+var (
+	hyphenRangeRe = regexp.MustCompile(`^(\S+)\s+-\s+(\S+)$`)
+	// Operatörle sürüm arasındaki boşluğu kaldırmak için (">= 1.2.3" -> ">=1.2.3")
+	opSpaceRe = regexp.MustCompile(`(>=|<=|>|<|=|~>|~|\^)\s+`)
+	partialRe = regexp.MustCompile(`^[v=]?(\d+|[xX*])(?:\.(\d+|[xX*]))?(?:\.(\d+|[xX*]))?(?:-([0-9A-Za-z.-]+))?(?:\+[0-9A-Za-z.-]+)?$`)
+)
+
+// ParseSemverRange npm aralığını okur. Git URL'leri, dist-tag'ler ("latest") ve
+// dosya yolları aralık değildir; bunlar için hata döner.
+func ParseSemverRange(s string) (SemverRange, error) {
+	var r SemverRange
+	for _, part := range strings.Split(s, "||") {
+		part = strings.TrimSpace(part)
+		var set []comparator
+		if m := hyphenRangeRe.FindStringSubmatch(part); m != nil {
+			lo, err := parsePartial(m[1])
+			if err != nil {
+				return r, err
+			}
+			hi, err := parsePartial(m[2])
+			if err != nil {
+				return r, err
+			}
+			set = append(set, lo.comparators(">=")...)
+			set = append(set, hi.comparators("<=")...)
+		} else {
+			for _, tok := range strings.Fields(opSpaceRe.ReplaceAllString(part, "$1")) {
+				cs, err := parseComparator(tok)
+				if err != nil {
+					return r, err
+				}
+				set = append(set, cs...)
+			}
+		}
+		r.sets = append(r.sets, set)
+	}
+	return r, nil
+}
+
+// Contains sürüm aralığa uyuyor mu. Ön sürümler, sadece aynı kümede aynı
+// major.minor.patch'e sahip bir ön sürüm koşulu varsa kabul edilir (npm davranışı).
+func (r SemverRange) Contains(v Semver) bool {
+	for _, set := range r.sets {
+		ok := true
+		for _, c := range set {
+			if !c.test(v) {
+				ok = false
+				break
+			}
+		}
+		if !ok {
+			continue
+		}
+		if len(v.Pre) == 0 {
+			return true
+		}
+		for _, c := range set {
+			if len(c.v.Pre) > 0 && c.v.sameTuple(v) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// partial eksik parçaları olabilen sürümdür ("1", "1.2", "1.x", "*")
+type partial struct {
+	parts []int // Belirtilen parçalar (wildcard'dan sonrası yok sayılır)
+	pre   []string
+}
+
+func parsePartial(s string) (partial, error) {
+	m := partialRe.FindStringSubmatch(s)
+	if m == nil {
+		return partial{}, fmt.Errorf("geçersiz sürüm: %q", s)
+	}
+	var p partial
+	for _, g := range m[1:4] {
+		if g == "" || g == "x" || g == "X" || g == "*" {
+			break
+		}
+		n, _ := strconv.Atoi(g)
+		p.parts = append(p.parts, n)
+	}
+	if m[4] != "" && len(p.parts) == 3 {
+		p.pre = strings.Split(m[4], ".")
+	}
+	return p, nil
+}
+
+// floor eksik parçaları 0 ile doldurur
+func (p partial) floor() Semver {
+	v := Semver{Pre: p.pre}
+	for i, n := range p.parts {
+		switch i {
+		case 0:
+			v.Major = n
+		case 1:
+			v.Minor = n
+		case 2:
+			v.Patch = n
+		}
+	}
+	return v
+}
+
+// bump belirtilen son parçayı artırır ("1.2" -> 1.3.0-0); üst sınırlarda ön sürümleri dışarıda bırakmak için -0 eklenir
+func (p partial) bump(idx int) Semver {
+	v := p.floor()
+	v.Pre = []string{"0"}
+	switch idx {
+	case 0:
+		v = Semver{Major: v.Major + 1, Pre: v.Pre}
+	case 1:
+		v = Semver{Major: v.Major, Minor: v.Minor + 1, Pre: v.Pre}
+	default:
+		v = Semver{Major: v.Major, Minor: v.Minor, Patch: v.Patch + 1, Pre: v.Pre}
+	}
+	return v
+}
+
+// comparators kısmi sürümü tek operatörlü koşullara çevirir
+func (p partial) comparators(op string) []comparator {
+	n := len(p.parts)
+	if n == 0 {
+		if op == "<" || op == ">" {
+			return []comparator{{"<", Semver{Pre: []string{"0"}}}} // Hiçbir sürüm
+		}
+		return nil // Her sürüm
+	}
+	full := n == 3
+	switch op {
+	case ">=":
+		return []comparator{{">=", p.floor()}}
+	case ">":
+		if full {
+			return []comparator{{">", p.floor()}}
+		}
+		next := p.bump(n - 1)
+		next.Pre = nil
+		return []comparator{{">=", next}}
+	case "<":
+		lo := p.floor()
+		if !full {
+			lo.Pre = []string{"0"}
+		}
+		return []comparator{{"<", lo}}
+	case "<=":
+		if full {
+			return []comparator{{"<=", p.floor()}}
+		}
+		return []comparator{{"<", p.bump(n - 1)}}
+	}
+	// "=" veya operatörsüz: "1.2" -> >=1.2.0 <1.3.0-0
+	if full {
+		return []comparator{{"=", p.floor()}}
+	}
+	return []comparator{{">=", p.floor()}, {"<", p.bump(n - 1)}}
+}
+
+func parseComparator(tok string) ([]comparator, error) {
+	op := ""
+	for _, o := range []string{">=", "<=", "~>", ">", "<", "=", "~", "^"} {
+		if strings.HasPrefix(tok, o) {
+			op, tok = o, tok[len(o):]
+			break
+		}
+	}
+	p, err := parsePartial(tok)
+	if err != nil {
+		return nil, err
+	}
+	n := len(p.parts)
+	switch op {
+	case "~", "~>":
+		// ~1.2.3 -> <1.3.0, ~1 -> <2.0.0
+		if n == 0 {
+			return nil, nil
+		}
+		idx := 1
+		if n == 1 {
+			idx = 0
+		}
+		return []comparator{{">=", p.floor()}, {"<", p.bump(idx)}}, nil
+	case "^":
+		// İlk sıfır olmayan parça sabit kalır: ^1.2.3 -> <2.0.0, ^0.2.3 -> <0.3.0, ^0.0.3 -> <0.0.4, ^0.0 -> <0.1.0
+		if n == 0 {
+			return nil, nil
+		}
+		idx := 0
+		for idx < n-1 && p.parts[idx] == 0 {
+			idx++
+		}
+		return []comparator{{">=", p.floor()}, {"<", p.bump(idx)}}, nil
+	}
+	return p.comparators(op), nil
+}
+
+// npmRangeSpec package.json'daki tanımdan aralık kısmını ayırır ("npm:react@^18" -> "^18").
+// "workspace:", "file:" gibi yerel tanımlar aralık değildir; çağıranlar onları localSpec ile eler.
+func npmRangeSpec(spec string) string {
+	spec = strings.TrimSpace(spec)
+	if strings.HasPrefix(spec, "npm:") {
+		alias := strings.TrimPrefix(spec, "npm:")
+		if i := strings.LastIndex(alias, "@"); i > 0 {
+			return alias[i+1:]
+		}
+		return "*"
+	}
+	return spec
+}
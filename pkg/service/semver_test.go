@@ -0,0 +1,156 @@
+package service
+
+import (
+	"testing"
+
+	"devterminal/pkg/domain"
+)
+
+func TestParseSemverRangeContains(t *testing.T) {
+	tests := []struct {
+		rng     string
+		version string
+		want    bool
+	}{
+		// Tam sürüm ve karşılaştırma operatörleri
+		{"1.2.3", "1.2.3", true},
+		{"1.2.3", "1.2.4", false},
+		{"=1.2.3", "1.2.3", true},
+		{">=1.2.3", "1.2.3", true},
+		{">=1.2.3", "1.2.2", false},
+		{">1.2.3", "1.2.3", false},
+		{"<2.0.0", "1.99.99", true},
+		{"<=2.0.0", "2.0.0", true},
+		{">= 1.2.3 < 2", "1.5.0", true},
+		{">= 1.2.3 < 2", "2.0.0", false},
+
+		// Tilde
+		{"~1.2.3", "1.2.9", true},
+		{"~1.2.3", "1.3.0", false},
+		{"~1.2", "1.2.0", true},
+		{"~1", "1.9.0", true},
+		{"~1", "2.0.0", false},
+
+		// Caret: ilk sıfır olmayan parça sabit
+		{"^1.2.3", "1.9.9", true},
+		{"^1.2.3", "2.0.0", false},
+		{"^1.2.3", "1.2.2", false},
+		{"^0.2.3", "0.2.9", true},
+		{"^0.2.3", "0.3.0", false},
+		{"^0.0.3", "0.0.3", true},
+		{"^0.0.3", "0.0.4", false},
+		{"^0.0", "0.0.9", true},
+		{"^0.0", "0.1.0", false},
+
+		// X-aralıkları ve kısmi sürümler
+		{"*", "3.4.5", true},
+		{"", "3.4.5", true},
+		{"1.x", "1.9.0", true},
+		{"1.x", "2.0.0", false},
+		{"1.2.x", "1.2.7", true},
+		{"1.2.X", "1.3.0", false},
+		{"1", "1.5.0", true},
+		{"1.2", "1.3.0", false},
+
+		// Tire aralıkları
+		{"1.2.3 - 2.3.4", "2.3.4", true},
+		{"1.2.3 - 2.3.4", "2.3.5", false},
+		{"1.2 - 2", "2.9.9", true},
+		{"1.2 - 2", "1.1.9", false},
+
+		// Birleşimler
+		{"^1.0.0 || ^3.0.0", "3.1.0", true},
+		{"^1.0.0 || ^3.0.0", "2.1.0", false},
+
+		// Ön sürümler sadece aynı tuple'da ön sürüm koşulu varsa
+		{"^1.2.3-beta.1", "1.2.3-beta.2", true},
+		{"^1.2.3-beta.1", "1.2.3-alpha.9", false},
+		{"^1.2.3-beta.1", "1.3.0-beta.1", false},
+		{"^1.2.3", "1.5.0-rc.1", false},
+		{">=1.0.0", "2.0.0-rc.1", false},
+		{"^1.2.3-beta.1", "1.2.3", true},
+
+		// Baştaki v / =
+		{"^v1.2.3", "1.4.0", true},
+	}
+	for _, tt := range tests {
+		rng, err := ParseSemverRange(tt.rng)
+		if err != nil {
+			t.Errorf("ParseSemverRange(%q): beklenmeyen hata: %v", tt.rng, err)
+			continue
+		}
+		v, ok := ParseSemver(tt.version)
+		if !ok {
+			t.Fatalf("ParseSemver(%q) başarısız", tt.version)
+		}
+		if got := rng.Contains(v); got != tt.want {
+			t.Errorf("%q Contains(%q) = %v, beklenen %v", tt.rng, tt.version, got, tt.want)
+		}
+	}
+}
+
+func TestParseSemverRangeInvalid(t *testing.T) {
+	for _, s := range []string{
+		"latest",
+		"next",
+		"git+https://github.com/user/repo.git",
+		"github:user/repo#v1.0.0",
+		"file:../local",
+		"1.2.3.4",
+		"^foo",
+	} {
+		if _, err := ParseSemverRange(s); err == nil {
+			t.Errorf("ParseSemverRange(%q): hata bekleniyordu", s)
+		}
+	}
+}
+
+func TestNpmRangeSpec(t *testing.T) {
+	tests := map[string]string{
+		"^18.2.0":            "^18.2.0",
+		"  ~1.0.0 ":          "~1.0.0",
+		"npm:react@^18":      "^18",
+		"npm:@scope/pkg@1.x": "1.x",
+		"npm:string-width":   "*",
+		"workspace:^1.0.0":   "workspace:^1.0.0",
+	}
+	for spec, want := range tests {
+		if got := npmRangeSpec(spec); got != want {
+			t.Errorf("npmRangeSpec(%q) = %q, beklenen %q", spec, got, want)
+		}
+	}
+}
+
+func TestClassifyUpdate(t *testing.T) {
+	tests := []struct {
+		current, target string
+		want            domain.UpdateKind
+	}{
+		{"1.2.3", "1.2.4", domain.UpdatePatch},
+		{"1.2.3", "1.3.0", domain.UpdateMinor},
+		{"1.2.3", "2.0.0", domain.UpdateMajor},
+		{"v1.2.3", "v1.2.10", domain.UpdatePatch},
+
+		// 1.0 öncesinde ilk sıfır olmayan parça major gibi davranır
+		{"0.2.1", "0.2.5", domain.UpdatePatch},
+		{"0.2.1", "0.3.0", domain.UpdateMajor},
+		{"0.0.3", "0.0.4", domain.UpdateMajor},
+		{"0.9.0", "1.0.0", domain.UpdateMajor},
+
+		// Ön sürümler
+		{"1.2.3", "2.0.0-rc.1", domain.UpdatePrerelease},
+		{"2.0.0-rc.1", "2.0.0", domain.UpdatePatch},
+		{"1.0.0", "1.0.1-0.20240101120000-abcdef123456", domain.UpdatePrerelease},
+
+		// Sınıflandırılamayanlar
+		{"1.2.3", "1.2.3", domain.UpdateUnknown},
+		{"1.3.0", "1.2.9", domain.UpdateUnknown},
+		{"latest", "1.0.0", domain.UpdateUnknown},
+		{"1.0.0", "", domain.UpdateUnknown},
+	}
+	for _, tt := range tests {
+		if got := ClassifyUpdate(tt.current, tt.target); got != tt.want {
+			t.Errorf("ClassifyUpdate(%q, %q) = %s, beklenen %s", tt.current, tt.target, got, tt.want)
+		}
+	}
+}
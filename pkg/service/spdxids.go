@@ -0,0 +1,46 @@
+package service
+
+import "strings"
+
+// spdxLicenseIDs npm ve Go ekosisteminde sık karşılaşılan SPDX lisans kimlikleridir
+// (https://spdx.org/licenses/). Liste doğrulama için değil, "mit" gibi yazımları kanonik
+// hale getirmek içindir; listede olmayan kimlikler sözdizimi geçerliyse olduğu gibi kullanılır.
+var spdxLicenseIDs = []string{
+	"0BSD", "AFL-1.1", "AFL-1.2", "AFL-2.0", "AFL-2.1", "AFL-3.0", "AGPL-1.0", "AGPL-1.0-only", "AGPL-1.0-or-later",
+	"AGPL-3.0", "AGPL-3.0-only", "AGPL-3.0-or-later", "Apache-1.0", "Apache-1.1", "Apache-2.0", "APSL-2.0",
+	"Artistic-1.0", "Artistic-2.0", "BlueOak-1.0.0", "BSD-1-Clause", "BSD-2-Clause", "BSD-2-Clause-Patent",
+	"BSD-3-Clause", "BSD-3-Clause-Clear", "BSD-4-Clause", "BSL-1.0", "BUSL-1.1", "bzip2-1.0.6",
+	"CAL-1.0", "CC-BY-3.0", "CC-BY-4.0", "CC-BY-NC-4.0", "CC-BY-NC-SA-4.0", "CC-BY-ND-4.0", "CC-BY-SA-3.0",
+	"CC-BY-SA-4.0", "CC0-1.0", "CDDL-1.0", "CDDL-1.1", "CECILL-2.1", "CPAL-1.0", "CPL-1.0", "ECL-2.0",
+	"EFL-2.0", "Elastic-2.0", "EPL-1.0", "EPL-2.0", "EUPL-1.1", "EUPL-1.2", "FSFAP", "GFDL-1.3", "GFDL-1.3-only",
+	"GFDL-1.3-or-later", "GPL-1.0", "GPL-1.0-only", "GPL-1.0-or-later", "GPL-2.0", "GPL-2.0-only",
+	"GPL-2.0-or-later", "GPL-3.0", "GPL-3.0-only", "GPL-3.0-or-later", "Hippocratic-2.1", "ICU", "IJG",
+	"Intel", "IPL-1.0", "ISC", "JSON", "LGPL-2.0", "LGPL-2.0-only", "LGPL-2.0-or-later", "LGPL-2.1",
+	"LGPL-2.1-only", "LGPL-2.1-or-later", "LGPL-3.0", "LGPL-3.0-only", "LGPL-3.0-or-later", "libpng-2.0",
+	"MIT", "MIT-0", "MIT-CMU", "MIT-Modern-Variant", "MirOS", "MPL-1.0", "MPL-1.1", "MPL-2.0",
+	"MPL-2.0-no-copyleft-exception", "MS-PL", "MS-RL", "MulanPSL-2.0", "NCSA", "ODbL-1.0", "OFL-1.0", "OFL-1.1",
+	"OpenSSL", "OSL-3.0", "PHP-3.0", "PHP-3.01", "PostgreSQL", "PSF-2.0", "Python-2.0", "Python-2.0.1",
+	"Ruby", "SSPL-1.0", "Unicode-3.0", "Unicode-DFS-2015", "Unicode-DFS-2016", "Unlicense", "UPL-1.0",
+	"Vim", "W3C", "W3C-20150513", "WTFPL", "X11", "Zlib", "zlib-acknowledgement", "ZPL-2.0", "ZPL-2.1",
+}
+
+// spdxExceptionIDs "WITH" sonrasında kullanılabilen SPDX istisna kimlikleridir
+var spdxExceptionIDs = []string{
+	"Autoconf-exception-3.0", "Bison-exception-2.2", "Classpath-exception-2.0", "GCC-exception-3.1",
+	"LLVM-exception", "OpenJDK-assembly-exception-1.0", "openvpn-openssl-exception", "Qt-GPL-exception-1.0",
+	"Qt-LGPL-exception-1.1", "Swift-exception", "Universal-FOSS-exception-1.0",
+}
+
+var (
+	spdxLicenseIndex   = spdxIndex(spdxLicenseIDs)
+	spdxExceptionIndex = spdxIndex(spdxExceptionIDs)
+)
+
+// spdxIndex küçük harfli kimlikten kanonik yazıma eşler (SPDX kimlikleri büyük/küçük harf duyarsızdır)
+func spdxIndex(ids []string) map[string]string {
+	m := make(map[string]string, len(ids))
+	for _, id := range ids {
+		m[strings.ToLower(id)] = id
+	}
+	return m
+}
@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"devterminal/pkg/domain"
+)
+
+// LaunchStep sıralı başlatmadaki tek bir adımdır
+type LaunchStep struct {
+	Name    string
+	Request TerminalRequest
+	Ready   domain.ReadinessProbe // Bir sonraki adıma geçmeden önce beklenecek kontrol
+}
+
+// LaunchStage bir adımın sıralı başlatmadaki durumu
+type LaunchStage string
+
+const (
+	StagePending  LaunchStage = "pending"
+	StageStarting LaunchStage = "starting"
+	StageWaiting  LaunchStage = "waiting" // Probe bekleniyor
+	StageReady    LaunchStage = "ready"
+	StageSkipped  LaunchStage = "skipped" // Başlatıldı ama probe uygulanamadı
+	StageFailed   LaunchStage = "failed"
+)
+
+// LaunchProgress sıralı başlatma sırasında UI'a gönderilen ilerleme bildirimi
+type LaunchProgress struct {
+	Step  int // steps içindeki sıra
+	Name  string
+	Stage LaunchStage
+	Probe string // Beklenen kontrolün açıklaması
+	Err   error
+}
+
+// LaunchPlan sıralı başlatmanın adımlarını ve neyin başlatıldığını tutar; geçmişten
+// tekrar çalıştırılan kayıt aynı adımlar, sıra ve hazır olma kontrolleriyle yeniden kurulur
+type LaunchPlan struct {
+	Kind    domain.LaunchKind
+	Mode    string   // LaunchProjectMode için: frontend, backend veya full
+	Targets []string // Alt proje anahtarları veya servis adları
+	Steps   []LaunchStep
+}
+
+// historyEntry adım için geçmiş kaydını başlatmanın türü ve tüm adımlarıyla hazırlar
+func (pl LaunchPlan) historyEntry(step LaunchStep) domain.HistoryEntry {
+	names := make([]string, len(pl.Steps))
+	for i, s := range pl.Steps {
+		names[i] = s.Name
+	}
+	return domain.HistoryEntry{
+		Kind: domain.HistoryLaunch, Name: step.Name, Target: pl.Mode,
+		Launch: pl.Kind, Targets: pl.Targets, Steps: names,
+	}
+}
+
+// ProjectPlan projenin frontend/backend başlatma planı
+func (l *Launcher) ProjectPlan(p *domain.Project, mode string) LaunchPlan {
+	return LaunchPlan{Kind: domain.LaunchProjectMode, Mode: mode, Steps: l.ProjectSteps(p, mode)}
+}
+
+// ReplayPlan geçmişteki sıralı başlatma kaydının planını projenin güncel tanımlarıyla yeniden kurar
+func (l *Launcher) ReplayPlan(p *domain.Project, e domain.HistoryEntry) (LaunchPlan, error) {
+	switch e.Launch {
+	case domain.LaunchProjectMode:
+		return l.ProjectPlan(p, e.Target), nil
+	case domain.LaunchSubProjects:
+		plan, _ := l.SubProjectPlan(p, e.Targets)
+		return plan, nil
+	case domain.LaunchServices:
+		return l.ServicePlan(p, e.Targets)
+	}
+	return LaunchPlan{}, fmt.Errorf("kayıt sıralı bir başlatma değil")
+}
+
+// ProjectSteps proje için başlatma adımlarını döndürür.
+// "full" modda backend önce gelir, böylece frontend hazır bir API'ye bağlanır.
+func (l *Launcher) ProjectSteps(p *domain.Project, mode string) []LaunchStep {
+	o := l.Config.ProjectOverrides[strings.ToLower(p.Path)]
+
+	var steps []LaunchStep
+	if (mode == "backend" || mode == "full") && p.BackendPath != "" {
+		steps = append(steps, LaunchStep{
+			Name:    p.Name + " Backend",
+			Request: TerminalRequest{Title: p.Name + " Backend", Dir: p.BackendPath, Command: p.BackendCmd},
+			Ready:   o.BackendReady,
+		})
+	}
+	if (mode == "frontend" || mode == "full") && p.FrontendPath != "" {
+		steps = append(steps, LaunchStep{
+			Name:    p.Name + " Frontend",
+			Request: TerminalRequest{Title: p.Name + " Frontend", Dir: p.FrontendPath, Command: p.FrontendCmd},
+			Ready:   o.FrontendReady,
+		})
+	}
+	return steps
+}
+
+// NeedsOrderedStart "full" modda bekleme gerektiren bir probe tanımlı mı
+func (l *Launcher) NeedsOrderedStart(p *domain.Project, mode string) bool {
+	steps := l.ProjectSteps(p, mode)
+	for i := 0; i < len(steps)-1; i++ {
+		if !steps[i].Ready.IsZero() {
+			return true
+		}
+	}
+	return false
+}
+
+// RunSteps adımları sırayla başlatır; her adımın hazır olmasını bekledikten sonra
+// bir sonrakine geçer. İlerleme progress kanalına yazılır ve iş bitince kanal kapatılır.
+// embedded true ise adımlar supervisor altında, değilse terminal backend'inde açılır.
+func (l *Launcher) RunSteps(ctx context.Context, p *domain.Project, plan LaunchPlan, embedded bool, progress chan<- LaunchProgress) error {
+	defer close(progress)
+	steps := plan.Steps
+	l.touchLastOpened(p)
+
+	restart := RestartSpecFromOverride(l.Config.ProjectOverrides[strings.ToLower(p.Path)])
+	report := func(i int, stage LaunchStage, err error) {
+		progress <- LaunchProgress{Step: i, Name: steps[i].Name, Stage: stage, Probe: DescribeProbe(steps[i].Ready), Err: err}
+	}
+
+	for i, step := range steps {
+		report(i, StageStarting, nil)
+
+		var logs *LogBuffer
+		var exited <-chan struct{}
+		if embedded {
+			r := step.Request
+			mp, err := l.Supervisor.Start(ProcessSpec{Name: step.Name, ProjectPath: p.Path, Dir: r.Dir, Command: r.Command, Env: r.Env, Restart: restart})
+			l.trackProcess(plan.historyEntry(step), p, mp, err)
+			if err != nil {
+				report(i, StageFailed, err)
+				return err
+			}
+			// Yeniden başlatma politikası varsa çöküşte değil, vazgeçildiğinde başarısız sayılır
+			logs, exited = mp.Logs, mp.GaveUp(ctx)
+		} else {
+			err := openInTerminal(l.Terminal, step.Request)
+			l.recordLaunched(plan.historyEntry(step), p, []TerminalRequest{step.Request}, err)
+			if err != nil {
+				report(i, StageFailed, err)
+				return err
+			}
+		}
+
+		// Son adımın hazır olmasını beklemeye gerek yok
+		if step.Ready.IsZero() || i == len(steps)-1 {
+			report(i, StageReady, nil)
+			continue
+		}
+
+		report(i, StageWaiting, nil)
+		err := WaitReady(ctx, step.Ready, logs, exited)
+		switch {
+		case err == nil:
+			report(i, StageReady, nil)
+		case errors.Is(err, ErrProbeUnsupported):
+			report(i, StageSkipped, err)
+		case errors.Is(err, context.Canceled):
+			err = fmt.Errorf("%s: başlatma iptal edildi", step.Name)
+			report(i, StageFailed, err)
+			return err
+		default:
+			err = fmt.Errorf("%s: %w", step.Name, err)
+			report(i, StageFailed, err)
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"path/filepath"
+
+	"devterminal/pkg/domain"
+)
+
+// SubProjectKey alt projeyi config'de saklamak için tanımlar (örn: "frontend:apps/web").
+// Aynı klasör hem frontend hem backend olabileceği için tarafı da içerir.
+func SubProjectKey(p *domain.Project, sp domain.SubProject) string {
+	side := "backend"
+	if sp.IsFrontend {
+		side = "frontend"
+	}
+	rel, err := filepath.Rel(p.Path, sp.Path)
+	if err != nil {
+		rel = sp.Path
+	}
+	return side + ":" + filepath.ToSlash(rel)
+}
+
+// SubProjects projenin tüm alt projelerini başlatma sırasıyla döndürür (önce backend'ler)
+func SubProjects(p *domain.Project) []domain.SubProject {
+	all := make([]domain.SubProject, 0, len(p.AllBackends)+len(p.AllFrontends))
+	all = append(all, p.AllBackends...)
+	all = append(all, p.AllFrontends...)
+	return all
+}
+
+// SubProjectPlan seçilen alt projelerin başlatma planı; atlanan alt projeler ayrıca döner
+func (l *Launcher) SubProjectPlan(p *domain.Project, keys []string) (LaunchPlan, []string) {
+	steps, skipped := l.SubProjectSteps(p, keys)
+	return LaunchPlan{Kind: domain.LaunchSubProjects, Targets: keys, Steps: steps}, skipped
+}
+
+// SubProjectSteps seçilen alt projeleri sıralı başlatma adımlarına çevirir.
+// Başlatma komutu olmadığı için atlanan alt projelerin adları ayrıca döner.
+func (l *Launcher) SubProjectSteps(p *domain.Project, keys []string) ([]LaunchStep, []string) {
+	selected := make(map[string]bool, len(keys))
+	for _, k := range keys {
+		selected[k] = true
+	}
+
+	var steps []LaunchStep
+	var skipped []string
+	for _, sp := range SubProjects(p) {
+		if !selected[SubProjectKey(p, sp)] {
+			continue
+		}
+		if sp.StartCmd == "" {
+			skipped = append(skipped, sp.Name)
+			continue
+		}
+		title := p.Name + " › " + sp.Name
+		steps = append(steps, LaunchStep{
+			Name:    title,
+			Request: TerminalRequest{Title: title, Dir: sp.Path, Command: sp.StartCmd},
+		})
+	}
+	return steps, skipped
+}
@@ -0,0 +1,558 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"sync"
+	"time"
+
+	"devterminal/pkg/domain"
+)
+
+// ProcessSpec supervisor tarafından yönetilecek bir süreci tanımlar
+type ProcessSpec struct {
+	Name        string   // Görünen ad (örn: "my-app frontend")
+	ProjectPath string   // Sürecin ait olduğu proje
+	Dir         string   // Çalışma dizini
+	Command     string   // Kabukta çalıştırılacak komut
+	Env         []string // Ek ortam değişkenleri (KEY=VALUE)
+	Restart     RestartSpec
+}
+
+// RestartSpec süreç kendiliğinden sonlandığında uygulanacak politikadır
+type RestartSpec struct {
+	Policy     domain.RestartPolicy
+	MaxRetries int           // Art arda en fazla deneme (0 = defaultMaxRetries)
+	Backoff    time.Duration // İlk bekleme, her denemede ikiye katlanır (0 = defaultBackoff)
+}
+
+const (
+	defaultMaxRetries = 5
+	defaultBackoff    = time.Second
+	maxBackoff        = time.Minute
+	// stableUptime bu süreden uzun çalışan süreçlerin deneme sayacı sıfırlanır
+	stableUptime = time.Minute
+)
+
+// RestartSpecFromOverride config'deki proje ayarlarını RestartSpec'e çevirir
+func RestartSpecFromOverride(o domain.ProjectOverride) RestartSpec {
+	spec := RestartSpec{Policy: o.Restart, MaxRetries: o.MaxRetries}
+	if d, err := time.ParseDuration(o.Backoff); err == nil {
+		spec.Backoff = d
+	}
+	return spec
+}
+
+// ProcessStatus sürecin yaşam döngüsündeki durumunu belirtir
+type ProcessStatus string
+
+const (
+	ProcessRunning ProcessStatus = "running"
+	ProcessExited  ProcessStatus = "exited"
+	ProcessStopped ProcessStatus = "stopped"
+	ProcessFailed  ProcessStatus = "failed"
+	ProcessBackoff ProcessStatus = "backoff" // Yeniden başlatılmayı bekliyor
+)
+
+// ProcessInfo bir sürecin anlık görüntüsüdür (UI için kilitsiz okunur)
+type ProcessInfo struct {
+	ID        int
+	Spec      ProcessSpec
+	PID       int
+	Status    ProcessStatus
+	StartedAt time.Time
+	ExitedAt  time.Time
+	ExitCode  int
+
+	Restarts       int       // Otomatik yeniden başlatma sayısı
+	LastExitReason string    // Son sonlanma nedeni (örn: "exit status 1")
+	NextRestart    time.Time // Backoff durumunda bir sonraki deneme zamanı
+
+	Watching bool // Dosya değişikliklerinde yeniden başlatılıyor mu
+	Reloads  int  // İzleme modunda yapılan yeniden başlatma sayısı
+}
+
+// Uptime sürecin ne kadar süredir çalıştığını (veya çalıştığı süreyi) döndürür
+func (i ProcessInfo) Uptime() time.Duration {
+	if i.StartedAt.IsZero() {
+		return 0
+	}
+	if i.Status == ProcessRunning {
+		return time.Since(i.StartedAt)
+	}
+	return i.ExitedAt.Sub(i.StartedAt)
+}
+
+// ManagedProcess supervisor'ün sahip olduğu tek bir alt süreçtir
+type ManagedProcess struct {
+	ID   int
+	Spec ProcessSpec
+	Logs *LogBuffer
+
+	mu        sync.Mutex
+	cmd       *exec.Cmd
+	pid       int
+	status    ProcessStatus
+	startedAt time.Time
+	exitedAt  time.Time
+	exitCode  int
+	stopping  bool
+	done      chan struct{}
+
+	restarts       int
+	attempt        int // Art arda başarısız deneme sayısı
+	lastExitReason string
+	nextRestart    time.Time
+	restartTimer   *time.Timer
+
+	watchCancel context.CancelFunc
+	reloads     int
+
+	gen int // Her start/stop'ta artar; eskimiş otomatik yeniden başlatmaları eler
+}
+
+func newManagedProcess(id int, spec ProcessSpec) *ManagedProcess {
+	return &ManagedProcess{ID: id, Spec: spec, Logs: NewLogBuffer(logCapacity)}
+}
+
+// Info sürecin anlık durumunu döndürür
+func (mp *ManagedProcess) Info() ProcessInfo {
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+	return ProcessInfo{
+		ID:        mp.ID,
+		Spec:      mp.Spec,
+		PID:       mp.pid,
+		Status:    mp.status,
+		StartedAt: mp.startedAt,
+		ExitedAt:  mp.exitedAt,
+		ExitCode:  mp.exitCode,
+
+		Restarts:       mp.restarts,
+		LastExitReason: mp.lastExitReason,
+		NextRestart:    mp.nextRestart,
+
+		Watching: mp.watchCancel != nil,
+		Reloads:  mp.reloads,
+	}
+}
+
+// Running süreç hâlâ çalışıyor mu
+func (mp *ManagedProcess) Running() bool {
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+	return mp.status == ProcessRunning
+}
+
+// Done süreç sonlandığında kapanan kanalı döndürür
+func (mp *ManagedProcess) Done() <-chan struct{} {
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+	return mp.done
+}
+
+// GaveUp süreç sonlandığında ve yeniden başlatılmayacağında (durduruldu, başarıyla bitti veya
+// deneme hakkı tükendi) kapanan bir kanal döndürür. Backoff'taki süreç için beklemeye devam eder.
+func (mp *ManagedProcess) GaveUp(ctx context.Context) <-chan struct{} {
+	out := make(chan struct{})
+	go func() {
+		for {
+			select {
+			case <-mp.Done():
+			case <-ctx.Done():
+				return
+			}
+			// wait() bir sonraki denemeyi kanal kapanmadan önce zamanlar
+			for mp.Info().Status == ProcessBackoff {
+				select {
+				case <-time.After(readyPollInterval):
+				case <-ctx.Done():
+					return
+				}
+			}
+			if !mp.Running() {
+				close(out)
+				return
+			}
+		}
+	}()
+	return out
+}
+
+// start süreci başlatır; bekleyen bir otomatik yeniden başlatma varsa geçersiz kılar
+func (mp *ManagedProcess) start() error {
+	mp.mu.Lock()
+	mp.gen++
+	gen := mp.gen
+	mp.mu.Unlock()
+	return mp.launch(gen, false)
+}
+
+// launch alt süreci oluşturur. gen, başlatma kararı verildiğindeki nesildir: arada
+// stop veya başka bir start çağrıldıysa (nesil değiştiyse) hiçbir şey yapılmaz.
+// Kontrol ve c.Start aynı kilit altında yapılır ki araya Stop/Restart giremesin.
+func (mp *ManagedProcess) launch(gen int, auto bool) error {
+	args := shellArgs(mp.Spec.Command)
+	c := exec.Command(args[0], args[1:]...)
+	c.Dir = mp.Spec.Dir
+	c.Env = append(os.Environ(), mp.Spec.Env...)
+	c.Stdout = mp.Logs
+	c.Stderr = mp.Logs
+	setProcessGroup(c)
+
+	mp.mu.Lock()
+	if mp.gen != gen {
+		// Bu arada kullanıcı durdurmuş veya yeniden başlatmış
+		mp.mu.Unlock()
+		return nil
+	}
+	if auto {
+		mp.restarts++
+		mp.nextRestart = time.Time{}
+		mp.Logs.Append(fmt.Sprintf("↻ Otomatik yeniden başlatma #%d", mp.restarts))
+	}
+	mp.Logs.Append(fmt.Sprintf("▶ %s  (%s)", mp.Spec.Command, mp.Spec.Dir))
+	if err := c.Start(); err != nil {
+		mp.status = ProcessFailed
+		mp.exitCode = -1
+		mp.lastExitReason = err.Error()
+		delay := mp.scheduleRestart(0)
+		mp.mu.Unlock()
+		mp.Logs.Append("✖ Başlatılamadı: " + err.Error())
+		mp.logRestart(delay)
+		return err
+	}
+
+	mp.cmd = c
+	mp.pid = c.Process.Pid
+	mp.status = ProcessRunning
+	mp.startedAt = time.Now()
+	mp.exitedAt = time.Time{}
+	mp.exitCode = 0
+	mp.stopping = false
+	mp.done = make(chan struct{})
+	done := mp.done
+	mp.mu.Unlock()
+
+	go mp.wait(c, done)
+	return nil
+}
+
+func (mp *ManagedProcess) wait(c *exec.Cmd, done chan struct{}) {
+	err := c.Wait()
+
+	mp.mu.Lock()
+	mp.exitedAt = time.Now()
+	mp.exitCode = 0
+	mp.lastExitReason = "exit status 0"
+	if err != nil {
+		mp.exitCode = -1
+		mp.lastExitReason = err.Error()
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			mp.exitCode = exitErr.ExitCode()
+		}
+	}
+	switch {
+	case mp.stopping:
+		mp.status = ProcessStopped
+	case mp.exitCode == 0:
+		mp.status = ProcessExited
+	default:
+		mp.status = ProcessFailed
+	}
+	code := mp.exitCode
+	var delay time.Duration
+	if !mp.stopping {
+		delay = mp.scheduleRestart(mp.exitedAt.Sub(mp.startedAt))
+	}
+	mp.mu.Unlock()
+
+	mp.Logs.Append(fmt.Sprintf("■ Süreç sonlandı (exit %d)", code))
+	mp.logRestart(delay)
+	close(done)
+}
+
+// scheduleRestart politikaya göre yeniden başlatmayı zamanlar ve bekleme süresini döndürür.
+// mp.mu kilitliyken çağrılmalıdır.
+func (mp *ManagedProcess) scheduleRestart(uptime time.Duration) time.Duration {
+	r := mp.Spec.Restart
+	switch r.Policy {
+	case domain.RestartAlways:
+	case domain.RestartOnFailure:
+		if mp.status != ProcessFailed {
+			return 0
+		}
+	default:
+		return 0
+	}
+
+	// Uzun süre ayakta kaldıysa önceki çöküşleri sayma
+	if uptime > stableUptime {
+		mp.attempt = 0
+	}
+	maxRetries := r.MaxRetries
+	if maxRetries <= 0 {
+		maxRetries = defaultMaxRetries
+	}
+	if mp.attempt >= maxRetries {
+		return -1
+	}
+
+	backoff := r.Backoff
+	if backoff <= 0 {
+		backoff = defaultBackoff
+	}
+	delay := backoff << mp.attempt
+	if delay > maxBackoff || delay <= 0 {
+		delay = maxBackoff
+	}
+	mp.attempt++
+
+	mp.status = ProcessBackoff
+	mp.nextRestart = time.Now().Add(delay)
+	mp.restartTimer = time.AfterFunc(delay, mp.autoRestart)
+	return delay
+}
+
+// logRestart zamanlanan yeniden başlatmayı log'a yazar
+func (mp *ManagedProcess) logRestart(delay time.Duration) {
+	switch {
+	case delay > 0:
+		mp.Logs.Append(fmt.Sprintf("⏳ %s sonra yeniden başlatılacak", delay))
+	case delay < 0:
+		mp.Logs.Append("⛔ Maksimum yeniden başlatma sayısına ulaşıldı")
+	}
+}
+
+// autoRestart backoff süresi dolduğunda süreci yeniden başlatır
+func (mp *ManagedProcess) autoRestart() {
+	if gen, ok := mp.pendingRestart(); ok {
+		_ = mp.launch(gen, true)
+	}
+}
+
+// pendingRestart süreç hâlâ backoff'taysa başlatma neslini döndürür
+func (mp *ManagedProcess) pendingRestart() (int, bool) {
+	mp.mu.Lock()
+	defer mp.mu.Unlock()
+	// Bu arada kullanıcı durdurmuş veya yeniden başlatmış
+	return mp.gen, mp.status == ProcessBackoff
+}
+
+// Stop süreci durdurur (supervisor'a kayıtlı olmayan süreçler için)
+func (mp *ManagedProcess) Stop() error {
+	return mp.stop(stopTimeout)
+}
+
+// stop süreci önce nazikçe, zaman aşımında zorla sonlandırır
+func (mp *ManagedProcess) stop(timeout time.Duration) error {
+	mp.mu.Lock()
+	// Zamanlayıcısı tetiklenmiş ama henüz başlatmamış bir autoRestart artık başlatmaz
+	mp.gen++
+	if mp.status == ProcessBackoff {
+		// Bekleyen otomatik yeniden başlatmayı iptal et
+		mp.restartTimer.Stop()
+		mp.status = ProcessStopped
+		mp.nextRestart = time.Time{}
+		mp.mu.Unlock()
+		return nil
+	}
+	if mp.status != ProcessRunning {
+		mp.mu.Unlock()
+		return nil
+	}
+	mp.stopping = true
+	pid := mp.pid
+	done := mp.done
+	mp.mu.Unlock()
+
+	_ = terminateProcessTree(pid)
+	select {
+	case <-done:
+		return nil
+	case <-time.After(timeout):
+	}
+
+	if err := killProcessTree(pid); err != nil {
+		return err
+	}
+	<-done
+	return nil
+}
+
+// Supervisor proje süreçlerini başlatır, izler ve durdurur
+type Supervisor struct {
+	mu     sync.Mutex
+	nextID int
+	procs  []*ManagedProcess
+}
+
+// NewSupervisor boş bir supervisor oluşturur
+func NewSupervisor() *Supervisor {
+	return &Supervisor{nextID: 1}
+}
+
+// logCapacity her süreç için tutulacak log satırı sayısı
+const logCapacity = 2000
+
+// stopTimeout SIGTERM sonrası SIGKILL'e kadar beklenecek süre
+const stopTimeout = 5 * time.Second
+
+// Start yeni bir süreç başlatır ve supervisor'a kaydeder
+func (s *Supervisor) Start(spec ProcessSpec) (*ManagedProcess, error) {
+	if spec.Command == "" {
+		return nil, fmt.Errorf("%s için komut tanımlı değil", spec.Name)
+	}
+
+	s.mu.Lock()
+	mp := newManagedProcess(s.nextID, spec)
+	s.nextID++
+	s.procs = append(s.procs, mp)
+	s.mu.Unlock()
+
+	return mp, mp.start()
+}
+
+// Get ID'ye göre süreci döndürür
+func (s *Supervisor) Get(id int) *ManagedProcess {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, mp := range s.procs {
+		if mp.ID == id {
+			return mp
+		}
+	}
+	return nil
+}
+
+// Processes kayıtlı tüm süreçleri başlatılma sırasıyla döndürür
+func (s *Supervisor) Processes() []*ManagedProcess {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make([]*ManagedProcess, len(s.procs))
+	copy(out, s.procs)
+	return out
+}
+
+// ProjectProcesses bir projeye ait süreçleri döndürür
+func (s *Supervisor) ProjectProcesses(projectPath string) []*ManagedProcess {
+	var out []*ManagedProcess
+	for _, mp := range s.Processes() {
+		if mp.Spec.ProjectPath == projectPath {
+			out = append(out, mp)
+		}
+	}
+	return out
+}
+
+// Stop süreci durdurur (elle durdurulan süreç izlenmeye devam etmez)
+func (s *Supervisor) Stop(id int) error {
+	mp := s.Get(id)
+	if mp == nil {
+		return fmt.Errorf("süreç bulunamadı: %d", id)
+	}
+	s.Unwatch(id)
+	return mp.stop(stopTimeout)
+}
+
+// Restart süreci durdurup aynı tanımla yeniden başlatır (loglar korunur)
+func (s *Supervisor) Restart(id int) error {
+	mp := s.Get(id)
+	if mp == nil {
+		return fmt.Errorf("süreç bulunamadı: %d", id)
+	}
+	if err := mp.stop(stopTimeout); err != nil {
+		return err
+	}
+	mp.mu.Lock()
+	mp.attempt = 0
+	mp.mu.Unlock()
+	mp.Logs.Append("↻ Yeniden başlatılıyor...")
+	return mp.start()
+}
+
+// Watch süreci w'nin bildirdiği her değişiklikte yeniden başlatır.
+// Süreç çökmüş olsa da (örn: derleme hatası) bir sonraki kayıtta tekrar başlatılır.
+func (s *Supervisor) Watch(id int, w *Watcher) error {
+	mp := s.Get(id)
+	if mp == nil {
+		return fmt.Errorf("süreç bulunamadı: %d", id)
+	}
+	s.Unwatch(id)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	changes, err := w.Start(ctx)
+	if err != nil {
+		cancel()
+		return err
+	}
+	mp.mu.Lock()
+	mp.watchCancel = cancel
+	mp.mu.Unlock()
+	mp.Logs.Append("👁 İzleniyor: " + w.Describe())
+
+	go func() {
+		for files := range changes {
+			mp.mu.Lock()
+			mp.reloads++
+			mp.mu.Unlock()
+			mp.Logs.Append("👁 Değişiklik: " + summarizeChanges(files))
+			_ = s.Restart(id)
+		}
+	}()
+	return nil
+}
+
+// Unwatch sürecin izleme modunu kapatır
+func (s *Supervisor) Unwatch(id int) {
+	if mp := s.Get(id); mp != nil {
+		mp.unwatch()
+	}
+}
+
+// unwatch sürecin izleyicisini durdurur
+func (mp *ManagedProcess) unwatch() {
+	mp.mu.Lock()
+	cancel := mp.watchCancel
+	mp.watchCancel = nil
+	mp.mu.Unlock()
+	if cancel != nil {
+		cancel()
+		mp.Logs.Append("👁 İzleme kapatıldı")
+	}
+}
+
+// Remove sonlanmış bir süreci listeden kaldırır; çalışan süreçlere (ve izleyicilerine) dokunmaz
+func (s *Supervisor) Remove(id int) {
+	s.mu.Lock()
+	var removed *ManagedProcess
+	for i, mp := range s.procs {
+		if mp.ID == id && !mp.Running() {
+			removed = mp
+			s.procs = append(s.procs[:i], s.procs[i+1:]...)
+			break
+		}
+	}
+	s.mu.Unlock()
+	if removed != nil {
+		removed.unwatch()
+	}
+}
+
+// StopAll çalışan tüm süreçleri durdurur (uygulamadan çıkarken)
+func (s *Supervisor) StopAll() {
+	var wg sync.WaitGroup
+	for _, mp := range s.Processes() {
+		wg.Add(1)
+		go func(mp *ManagedProcess) {
+			defer wg.Done()
+			s.Unwatch(mp.ID)
+			_ = mp.stop(stopTimeout)
+		}(mp)
+	}
+	wg.Wait()
+}
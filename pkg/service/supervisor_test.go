@@ -0,0 +1,131 @@
+package service
+
+import (
+	"runtime"
+	"testing"
+	"time"
+
+	"devterminal/pkg/domain"
+)
+
+// waitStatus süreç beklenen duruma geçene kadar bekler
+func waitStatus(t *testing.T, mp *ManagedProcess, want ProcessStatus) {
+	t.Helper()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		if mp.Info().Status == want {
+			return
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Fatalf("durum %q, beklenen %q", mp.Info().Status, want)
+}
+
+func skipWithoutShell(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("sh gerektirir")
+	}
+}
+
+// failingSpec hemen çöken ve uzun bir backoff ile yeniden başlatılan süreç
+func failingSpec() ProcessSpec {
+	return ProcessSpec{
+		Name: "crash", Dir: ".", Command: "exit 3",
+		Restart: RestartSpec{Policy: domain.RestartOnFailure, MaxRetries: 3, Backoff: time.Hour},
+	}
+}
+
+func TestSupervisorBackoffAfterCrash(t *testing.T) {
+	skipWithoutShell(t)
+	s := NewSupervisor()
+	mp, err := s.Start(failingSpec())
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitStatus(t, mp, ProcessBackoff)
+	info := mp.Info()
+	if info.ExitCode != 3 || info.NextRestart.IsZero() {
+		t.Errorf("exit %d, sonraki deneme %v", info.ExitCode, info.NextRestart)
+	}
+
+	// Zamanlayıcı tetiklenince yeniden başlatılır ve tekrar backoff'a düşer
+	mp.autoRestart()
+	waitStatus(t, mp, ProcessBackoff)
+	if got := mp.Info().Restarts; got != 1 {
+		t.Errorf("Restarts = %d, beklenen 1", got)
+	}
+	_ = s.Stop(mp.ID)
+}
+
+func TestSupervisorMaxRetries(t *testing.T) {
+	skipWithoutShell(t)
+	spec := failingSpec()
+	spec.Restart.MaxRetries = 1
+	mp, err := NewSupervisor().Start(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitStatus(t, mp, ProcessBackoff)
+	mp.autoRestart()
+	waitStatus(t, mp, ProcessFailed)
+}
+
+func TestSupervisorStopDuringBackoff(t *testing.T) {
+	skipWithoutShell(t)
+	s := NewSupervisor()
+	mp, err := s.Start(failingSpec())
+	if err != nil {
+		t.Fatal(err)
+	}
+	waitStatus(t, mp, ProcessBackoff)
+
+	// Zamanlayıcı tetiklendi, autoRestart başlatmadan önce kullanıcı durdurdu
+	gen, ok := mp.pendingRestart()
+	if !ok {
+		t.Fatal("backoff bekleniyordu")
+	}
+	if err := s.Stop(mp.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := mp.launch(gen, true); err != nil {
+		t.Fatal(err)
+	}
+	info := mp.Info()
+	if info.Status != ProcessStopped || info.Restarts != 0 {
+		t.Errorf("durum %q, restarts %d; durdurulmuş süreç yeniden başlatılmamalı", info.Status, info.Restarts)
+	}
+}
+
+func TestSupervisorRestartDuringBackoff(t *testing.T) {
+	skipWithoutShell(t)
+	s := NewSupervisor()
+	spec := failingSpec()
+	// İlk çalıştırmada çöker, sonrakilerde ayakta kalır
+	spec.Dir = t.TempDir()
+	spec.Command = "if [ -f ran ]; then sleep 30; else touch ran; exit 1; fi"
+	mp, err := s.Start(spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.StopAll()
+	waitStatus(t, mp, ProcessBackoff)
+
+	// Zamanlayıcı tetiklendi, autoRestart başlatmadan önce kullanıcı yeniden başlattı
+	gen, ok := mp.pendingRestart()
+	if !ok {
+		t.Fatal("backoff bekleniyordu")
+	}
+	if err := s.Restart(mp.ID); err != nil {
+		t.Fatal(err)
+	}
+	waitStatus(t, mp, ProcessRunning)
+	pid := mp.Info().PID
+	if err := mp.launch(gen, true); err != nil {
+		t.Fatal(err)
+	}
+	info := mp.Info()
+	if info.PID != pid || info.Status != ProcessRunning || info.Restarts != 0 {
+		t.Errorf("PID %d (beklenen %d), durum %q, restarts %d; eski autoRestart ikinci bir süreç başlatmamalı",
+			info.PID, pid, info.Status, info.Restarts)
+	}
+}
@@ -0,0 +1,418 @@
+package service
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+
+	"devterminal/pkg/domain"
+
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+)
+
+// TaskSource bir görev kaynağını (package.json, Makefile, justfile...) okur
+type TaskSource interface {
+	// Name listede gruplama için kullanılan kaynak adı (örn: "npm", "make")
+	Name() string
+	// Discover klasördeki görevleri bulur; Run alanı kaynağın kendi çalıştırıcısıyla doldurulur
+	Discover(dir string) []domain.Task
+}
+
+// TaskSources desteklenen görev kaynaklarını listede görünecekleri sırayla döndürür
+func (s *Scanner) TaskSources() []TaskSource {
+	return []TaskSource{
+		npmTasks{pm: ResolvePackageManager},
+		makeTasks{},
+		taskfileTasks{},
+		justTasks{},
+		composerTasks{},
+		denoTasks{},
+		pyprojectTasks{},
+	}
+}
+
+// scanTasks projenin kökü, frontend ve backend klasörlerindeki tüm görevleri toplar.
+// Alt klasör görevleri "client:" / "server:" önekiyle adlandırılır.
+func (s *Scanner) scanTasks(p *domain.Project) []domain.Task {
+	type location struct{ dir, prefix string }
+	locations := []location{{p.Path, ""}}
+	if p.FrontendPath != "" && p.FrontendPath != p.Path {
+		locations = append(locations, location{p.FrontendPath, "client"})
+	}
+	if p.BackendPath != "" && p.BackendPath != p.Path && p.BackendPath != p.FrontendPath {
+		locations = append(locations, location{p.BackendPath, "server"})
+	}
+
+	sources := s.TaskSources()
+	order := make(map[string]int, len(sources))
+	var tasks []domain.Task
+	for i, src := range sources {
+		order[src.Name()] = i
+		for _, loc := range locations {
+			for _, t := range src.Discover(loc.dir) {
+				if loc.prefix != "" {
+					t.Name = loc.prefix + ":" + t.Name
+				}
+				tasks = append(tasks, t)
+			}
+		}
+	}
+
+	// Kaynağa göre grupla, grup içinde ada göre sırala
+	sort.SliceStable(tasks, func(i, j int) bool {
+		if tasks[i].Source != tasks[j].Source {
+			return order[tasks[i].Source] < order[tasks[j].Source]
+		}
+		return tasks[i].Name < tasks[j].Name
+	})
+	return tasks
+}
+
+// --- package.json ---
+
+type npmTasks struct {
+	pm func(dir string) PackageManager
+}
+
+func (npmTasks) Name() string { return "npm" }
+
+func (t npmTasks) Discover(dir string) []domain.Task {
+	data, err := os.ReadFile(filepath.Join(dir, "package.json"))
+	if err != nil {
+		return nil
+	}
+	var pkg packageJSON
+	if json.Unmarshal(data, &pkg) != nil {
+		return nil
+	}
+
+	pm := t.pm(dir)
+	var tasks []domain.Task
+	for name, cmd := range pkg.Scripts {
+		tasks = append(tasks, domain.Task{Name: name, Source: t.Name(), Dir: dir, Command: cmd, Run: commandLine(pm.Run(name))})
+	}
+	return tasks
+}
+
+// --- Makefile ---
+
+type makeTasks struct{}
+
+func (makeTasks) Name() string { return "make" }
+
+// makeTargetRe "build: deps ## açıklama" satırlarını yakalar (":=" ve "::=" atamaları hariç)
+var makeTargetRe = regexp.MustCompile(`^([A-Za-z0-9][A-Za-z0-9_.\-/]*)\s*:([^=]|$)(.*)$`)
+
+func (t makeTasks) Discover(dir string) []domain.Task {
+	var path string
+	for _, name := range []string{"GNUmakefile", "Makefile", "makefile"} {
+		if _, err := os.Stat(filepath.Join(dir, name)); err == nil {
+			path = filepath.Join(dir, name)
+			break
+		}
+	}
+	if path == "" {
+		return nil
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+
+	seen := make(map[string]bool)
+	var tasks []domain.Task
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		m := makeTargetRe.FindStringSubmatch(sc.Text())
+		if m == nil || seen[m[1]] || strings.Contains(m[1], "%") || strings.HasPrefix(m[2]+m[3], ":=") {
+			continue
+		}
+		seen[m[1]] = true
+		desc := "make " + m[1]
+		if _, after, ok := strings.Cut(m[2]+m[3], "##"); ok {
+			desc = strings.TrimSpace(after)
+		}
+		tasks = append(tasks, domain.Task{Name: m[1], Source: t.Name(), Dir: dir, Command: desc, Run: "make " + m[1]})
+	}
+	return tasks
+}
+
+// --- Taskfile (go-task) ---
+
+type taskfileTasks struct{}
+
+func (taskfileTasks) Name() string { return "task" }
+
+func (t taskfileTasks) Discover(dir string) []domain.Task {
+	var data []byte
+	for _, name := range []string{"Taskfile.yml", "Taskfile.yaml", "taskfile.yml", "taskfile.yaml"} {
+		if b, err := os.ReadFile(filepath.Join(dir, name)); err == nil {
+			data = b
+			break
+		}
+	}
+	if data == nil {
+		return nil
+	}
+
+	var tf struct {
+		Tasks map[string]yaml.Node `yaml:"tasks"`
+	}
+	if yaml.Unmarshal(data, &tf) != nil {
+		return nil
+	}
+
+	var tasks []domain.Task
+	for name, node := range tf.Tasks {
+		var def struct {
+			Desc     string `yaml:"desc"`
+			Summary  string `yaml:"summary"`
+			Internal bool   `yaml:"internal"`
+		}
+		_ = node.Decode(&def) // Kısa biçimde (string/liste) görev tanımı olabilir
+		if def.Internal {
+			continue
+		}
+		desc := def.Desc
+		if desc == "" {
+			desc = def.Summary
+		}
+		if desc == "" {
+			desc = "task " + name
+		}
+		tasks = append(tasks, domain.Task{Name: name, Source: t.Name(), Dir: dir, Command: desc, Run: "task " + name})
+	}
+	return tasks
+}
+
+// --- justfile ---
+
+type justTasks struct{}
+
+func (justTasks) Name() string { return "just" }
+
+// justRecipeRe sütun başındaki "@name arg='x': deps" tarifini yakalar
+var justRecipeRe = regexp.MustCompile(`^@?([A-Za-z_][A-Za-z0-9_-]*)(\s+[^:]*)?:([^=]|$)`)
+
+func (t justTasks) Discover(dir string) []domain.Task {
+	var path string
+	for _, name := range []string{"justfile", "Justfile", ".justfile"} {
+		if _, err := os.Stat(filepath.Join(dir, name)); err == nil {
+			path = filepath.Join(dir, name)
+			break
+		}
+	}
+	if path == "" {
+		return nil
+	}
+	f, err := os.Open(path)
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+
+	var tasks []domain.Task
+	comment := ""
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		line := sc.Text()
+		if strings.HasPrefix(line, "#") {
+			// Tarifin hemen üstündeki yorum açıklama olarak kullanılır
+			comment = strings.TrimSpace(strings.TrimPrefix(line, "#"))
+			continue
+		}
+		m := justRecipeRe.FindStringSubmatch(line)
+		name := ""
+		if m != nil {
+			name = m[1]
+		}
+		switch {
+		case name == "" || strings.HasPrefix(name, "_"):
+		case name == "set" || name == "alias" || name == "export" || name == "import" || name == "mod":
+		default:
+			desc := comment
+			if desc == "" {
+				desc = "just " + name
+			}
+			tasks = append(tasks, domain.Task{Name: name, Source: t.Name(), Dir: dir, Command: desc, Run: "just " + name})
+		}
+		comment = ""
+	}
+	return tasks
+}
+
+// --- composer.json ---
+
+type composerTasks struct{}
+
+func (composerTasks) Name() string { return "composer" }
+
+func (t composerTasks) Discover(dir string) []domain.Task {
+	data, err := os.ReadFile(filepath.Join(dir, "composer.json"))
+	if err != nil {
+		return nil
+	}
+	var c struct {
+		Scripts map[string]json.RawMessage `json:"scripts"`
+	}
+	if json.Unmarshal(data, &c) != nil {
+		return nil
+	}
+
+	var tasks []domain.Task
+	for name, raw := range c.Scripts {
+		// Composer olay kancaları (pre-install-cmd vb.) elle çalıştırılmaz
+		if strings.HasPrefix(name, "pre-") || strings.HasPrefix(name, "post-") {
+			continue
+		}
+		tasks = append(tasks, domain.Task{Name: name, Source: t.Name(), Dir: dir, Command: rawCommand(raw), Run: "composer run-script " + name})
+	}
+	return tasks
+}
+
+// rawCommand string veya string listesi olarak yazılmış komutu tek satıra çevirir
+func rawCommand(raw json.RawMessage) string {
+	var s string
+	if json.Unmarshal(raw, &s) == nil {
+		return s
+	}
+	var list []string
+	if json.Unmarshal(raw, &list) == nil {
+		return strings.Join(list, " && ")
+	}
+	var obj struct {
+		Command     string `json:"command"`
+		Description string `json:"description"`
+	}
+	if json.Unmarshal(raw, &obj) == nil {
+		if obj.Description != "" {
+			return obj.Description
+		}
+		return obj.Command
+	}
+	return ""
+}
+
+// --- deno.json ---
+
+type denoTasks struct{}
+
+func (denoTasks) Name() string { return "deno" }
+
+func (t denoTasks) Discover(dir string) []domain.Task {
+	var data []byte
+	for _, name := range []string{"deno.json", "deno.jsonc"} {
+		if b, err := os.ReadFile(filepath.Join(dir, name)); err == nil {
+			data = stripJSONLineComments(b)
+			break
+		}
+	}
+	if data == nil {
+		return nil
+	}
+	var d struct {
+		Tasks map[string]json.RawMessage `json:"tasks"`
+	}
+	if json.Unmarshal(data, &d) != nil {
+		return nil
+	}
+
+	var tasks []domain.Task
+	for name, raw := range d.Tasks {
+		tasks = append(tasks, domain.Task{Name: name, Source: t.Name(), Dir: dir, Command: rawCommand(raw), Run: "deno task " + name})
+	}
+	return tasks
+}
+
+// stripJSONLineComments jsonc dosyalarındaki tam satır "//" yorumlarını temizler
+func stripJSONLineComments(data []byte) []byte {
+	lines := strings.Split(string(data), "\n")
+	out := lines[:0]
+	for _, line := range lines {
+		if !strings.HasPrefix(strings.TrimSpace(line), "//") {
+			out = append(out, line)
+		}
+	}
+	return []byte(strings.Join(out, "\n"))
+}
+
+// --- pyproject.toml ---
+
+type pyprojectTasks struct{}
+
+func (pyprojectTasks) Name() string { return "python" }
+
+func (t pyprojectTasks) Discover(dir string) []domain.Task {
+	data, err := os.ReadFile(filepath.Join(dir, "pyproject.toml"))
+	if err != nil {
+		return nil
+	}
+	var py struct {
+		Project struct {
+			Scripts map[string]string `toml:"scripts"`
+		} `toml:"project"`
+		Tool struct {
+			Poe struct {
+				Tasks map[string]any `toml:"tasks"`
+			} `toml:"poe"`
+			Poetry struct {
+				Scripts map[string]any `toml:"scripts"`
+			} `toml:"poetry"`
+		} `toml:"tool"`
+	}
+	if toml.Unmarshal(data, &py) != nil {
+		return nil
+	}
+
+	// Sanal ortam yöneticisine göre çalıştırıcı öneki
+	runner := ""
+	if _, err := os.Stat(filepath.Join(dir, "uv.lock")); err == nil {
+		runner = "uv run "
+	} else if _, err := os.Stat(filepath.Join(dir, "poetry.lock")); err == nil {
+		runner = "poetry run "
+	}
+
+	var tasks []domain.Task
+	for name, def := range py.Tool.Poe.Tasks {
+		tasks = append(tasks, domain.Task{Name: name, Source: t.Name(), Dir: dir, Command: poeTaskCommand(def), Run: runner + "poe " + name})
+	}
+
+	// [project.scripts] ve [tool.poetry.scripts] konsol komutları
+	entries := make(map[string]string)
+	for name, target := range py.Project.Scripts {
+		entries[name] = target
+	}
+	for name, target := range py.Tool.Poetry.Scripts {
+		if s, ok := target.(string); ok {
+			entries[name] = s
+		}
+	}
+	for name, target := range entries {
+		tasks = append(tasks, domain.Task{Name: name, Source: t.Name(), Dir: dir, Command: target, Run: runner + name})
+	}
+	return tasks
+}
+
+// poeTaskCommand poe görev tanımından gösterilecek komutu çıkarır
+func poeTaskCommand(def any) string {
+	switch v := def.(type) {
+	case string:
+		return v
+	case []any:
+		return fmt.Sprintf("%d adımlı dizi", len(v))
+	case map[string]any:
+		for _, key := range []string{"help", "cmd", "script", "shell", "ref"} {
+			if s, ok := v[key].(string); ok && s != "" {
+				return s
+			}
+		}
+	}
+	return ""
+}
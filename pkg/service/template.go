@@ -0,0 +1,251 @@
+package service
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"devterminal/pkg/config"
+	"devterminal/pkg/domain"
+)
+
+// TemplateData tüm Commands şablonlarının genişletildiği bağlamdır.
+// Proje alanlarına eskisi gibi doğrudan erişilir ({{.FrontendPath}}, {{.BackendCmd}}).
+type TemplateData struct {
+	*domain.Project
+	Mode           string            // frontend, backend veya full
+	Port           int               // Hazır olma kontrolünün portu veya ortamdaki PORT, bilinmiyorsa 0
+	PackageManager string            // Modun dizinindeki paket yöneticisi (npm, pnpm, yarn, bun)
+	PackageExec    string            // Paket binary'si çalıştırıcısı (npx, pnpm exec, yarn, bunx)
+	OS             string            // runtime.GOOS (windows, linux, darwin)
+	ConfigDir      string            // ~/.devterminal
+	Env            map[string]string // Süreç ortamı + projenin .env dosyaları
+}
+
+// templateEnvFiles sırayla okunur; önceden tanımlı bir anahtar ezilmez
+var templateEnvFiles = []string{".env.local", ".env"}
+
+// newTemplateData proje ve başlatma modu için şablon bağlamını oluşturur
+func (l *Launcher) newTemplateData(p *domain.Project, mode string) *TemplateData {
+	data := &TemplateData{
+		Project: p,
+		Mode:    mode,
+		OS:      runtime.GOOS,
+		Env:     make(map[string]string),
+	}
+	if dir, err := config.ConfigDir(); err == nil {
+		data.ConfigDir = dir
+	}
+
+	for _, kv := range os.Environ() {
+		if k, v, ok := strings.Cut(kv, "="); ok {
+			data.Env[k] = v
+		}
+	}
+	// Önce modun dizini, sonra proje kökü (alt projenin .env'i öncelikli)
+	dirs := []string{modeDir(p, mode)}
+	if dirs[0] != p.Path {
+		dirs = append(dirs, p.Path)
+	}
+	for _, dir := range dirs {
+		for _, name := range templateEnvFiles {
+			for k, v := range readEnvFile(filepath.Join(dir, name)) {
+				if _, ok := data.Env[k]; !ok {
+					data.Env[k] = v
+				}
+			}
+		}
+	}
+
+	data.setPackageManager(ResolvePackageManager(dirs[0]))
+	data.Port = l.templatePort(p, mode, data.Env)
+	return data
+}
+
+// setPackageManager paket yöneticisi alanlarını doldurur
+func (d *TemplateData) setPackageManager(pm PackageManager) {
+	d.PackageManager = pm.Name
+	argv := pm.Exec("")
+	d.PackageExec = strings.Join(argv[:len(argv)-1], " ")
+}
+
+// modeDir başlatma modunun çalışma dizinini döndürür
+func modeDir(p *domain.Project, mode string) string {
+	switch {
+	case mode == "frontend" && p.FrontendPath != "":
+		return p.FrontendPath
+	case mode == "backend" && p.BackendPath != "":
+		return p.BackendPath
+	}
+	return p.Path
+}
+
+// templatePort modun hazır olma kontrolündeki portu, yoksa PORT değişkenini seçer
+func (l *Launcher) templatePort(p *domain.Project, mode string, env map[string]string) int {
+	o := l.Config.ProjectOverrides[strings.ToLower(p.Path)]
+	probes := []domain.ReadinessProbe{o.FrontendReady, o.BackendReady}
+	if mode == "backend" {
+		probes = []domain.ReadinessProbe{o.BackendReady, o.FrontendReady}
+	}
+	for _, probe := range probes {
+		if port := probePort(probe); port > 0 {
+			return port
+		}
+	}
+	port, _ := strconv.Atoi(strings.TrimSpace(env["PORT"]))
+	return port
+}
+
+// probePort tcp veya http kontrolünden portu çıkarır
+func probePort(probe domain.ReadinessProbe) int {
+	if probe.TCP != "" {
+		addr := probeAddr(probe.TCP)
+		port, _ := strconv.Atoi(addr[strings.LastIndex(addr, ":")+1:])
+		return port
+	}
+	if probe.HTTP != "" {
+		if u, err := url.Parse(probe.HTTP); err == nil {
+			port, _ := strconv.Atoi(u.Port())
+			return port
+		}
+	}
+	return 0
+}
+
+// readEnvFile .env dosyasındaki KEY=VALUE satırlarını okur (dosya yoksa nil)
+func readEnvFile(path string) map[string]string {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+
+	env := make(map[string]string)
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimPrefix(line, "export ")
+		k, v, ok := strings.Cut(line, "=")
+		if !ok {
+			continue
+		}
+		k, v = strings.TrimSpace(k), strings.TrimSpace(v)
+		if len(v) >= 2 && (v[0] == '"' || v[0] == '\'') && v[len(v)-1] == v[0] {
+			v = v[1 : len(v)-1]
+		} else if i := strings.Index(v, " #"); i >= 0 {
+			v = strings.TrimSpace(v[:i])
+		}
+		env[k] = v
+	}
+	return env
+}
+
+// templateFuncs tüm Commands şablonlarında kullanılabilen fonksiyonlardır.
+// quote genişletilen komutu kimin böleceğine göre seçilir: parseArgs için quoteArg,
+// kabukta (sh -c / cmd /k) çalışan komutlar için quoteShellArg.
+func templateFuncs(data *TemplateData, quote func(string) string) template.FuncMap {
+	return template.FuncMap{
+		// {{shellquote .FrontendPath}} -> komutu bölen tarafın tek argüman olarak okuyacağı hali
+		"shellquote": quote,
+		// {{env "API_URL"}} veya {{env "API_URL" "http://localhost:3000"}}
+		"env": func(name string, def ...string) string {
+			if data != nil {
+				if v, ok := data.Env[name]; ok && v != "" {
+					return v
+				}
+			}
+			if len(def) > 0 {
+				return def[0]
+			}
+			return ""
+		},
+		// {{default 3000 .Port}} -> değer boşsa (0, "", nil) varsayılanı döndürür
+		"default": func(def, v any) any {
+			if v == nil {
+				return def
+			}
+			if rv := reflect.ValueOf(v); rv.IsZero() {
+				return def
+			}
+			return v
+		},
+		// {{join " " .SomeList}}
+		"join": func(sep string, list any) (string, error) {
+			rv := reflect.ValueOf(list)
+			if rv.Kind() != reflect.Slice && rv.Kind() != reflect.Array {
+				return "", fmt.Errorf("join: liste bekleniyor, %T verildi", list)
+			}
+			parts := make([]string, rv.Len())
+			for i := range parts {
+				parts[i] = fmt.Sprint(rv.Index(i).Interface())
+			}
+			return strings.Join(parts, sep), nil
+		},
+		// {{pathjoin .ConfigDir "logs"}} -> platformun ayırıcısıyla birleştirir
+		"pathjoin": func(elem ...string) string {
+			return filepath.Join(elem...)
+		},
+	}
+}
+
+// quoteArg s'yi parseArgs'ın tam olarak tek argüman olarak okuyacağı şekilde tırnaklar.
+// parseArgs kaçış karakteri desteklemediği için çift tırnaklar tek tırnak içinde yazılır.
+func quoteArg(s string) string {
+	if s != "" && !strings.ContainsAny(s, " \t\"'") {
+		return s
+	}
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, r := range s {
+		if r == '"' {
+			b.WriteString(`"'"'"`)
+			continue
+		}
+		b.WriteRune(r)
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// parseTemplate şablonu ortak FuncMap ile ayrıştırır; tanımsız .Env anahtarları boş metne genişler
+func parseTemplate(name, text string, data *TemplateData, quote func(string) string) (*template.Template, error) {
+	tmpl, err := template.New(name).Option("missingkey=zero").Funcs(templateFuncs(data, quote)).Parse(text)
+	if err != nil {
+		return nil, newTemplateError(name, err)
+	}
+	return tmpl, nil
+}
+
+// renderTemplate parseArgs ile bölünüp doğrudan çalıştırılacak şablonu genişletir;
+// hatalar *TemplateError olarak döner
+func renderTemplate(name, text string, data *TemplateData) (string, error) {
+	return executeTemplate(name, text, data, quoteArg)
+}
+
+// renderShellTemplate kabukta çalıştırılacak (araç komutları gibi) şablonu genişletir
+func renderShellTemplate(name, text string, data *TemplateData) (string, error) {
+	return executeTemplate(name, text, data, quoteShellArg)
+}
+
+func executeTemplate(name, text string, data *TemplateData, quote func(string) string) (string, error) {
+	tmpl, err := parseTemplate(name, text, data, quote)
+	if err != nil {
+		return "", err
+	}
+	var out bytes.Buffer
+	if err := tmpl.Execute(&out, data); err != nil {
+		return "", newTemplateError(name, err)
+	}
+	return out.String(), nil
+}
@@ -0,0 +1,48 @@
+package service
+
+import (
+	"os/exec"
+	"testing"
+)
+
+var shellquoteValues = []string{
+	"plain",
+	"/home/user/My Projects/app",
+	`say "hi"`,
+	"it's",
+	`mix "a" 'b' c`,
+	"$HOME; rm -rf ~ && echo `id` | cat > out",
+	"",
+}
+
+func TestShellquoteLaunchTemplate(t *testing.T) {
+	for _, v := range shellquoteValues {
+		data := &TemplateData{Env: map[string]string{"V": v}}
+		out, err := renderTemplate("launch_full", `app --dir {{shellquote (env "V")}} --x`, data)
+		if err != nil {
+			t.Fatalf("renderTemplate(%q): %v", v, err)
+		}
+		args := parseArgs(out)
+		if len(args) != 4 || args[2] != v {
+			t.Errorf("parseArgs(%s) = %q, 3. argüman %q bekleniyor", out, args, v)
+		}
+	}
+}
+
+func TestShellquoteToolCommand(t *testing.T) {
+	skipWithoutShell(t)
+	for _, v := range shellquoteValues {
+		data := &TemplateData{Env: map[string]string{"V": v}}
+		out, err := renderShellTemplate("tools.x.command", `printf %s {{env "V" | shellquote}}`, data)
+		if err != nil {
+			t.Fatalf("renderShellTemplate(%q): %v", v, err)
+		}
+		got, err := exec.Command("sh", "-c", out).Output()
+		if err != nil {
+			t.Fatalf("sh -c %s: %v", out, err)
+		}
+		if string(got) != v {
+			t.Errorf("sh -c %s = %q, beklenen %q", out, got, v)
+		}
+	}
+}
@@ -0,0 +1,373 @@
+package service
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+)
+
+// TerminalRequest yeni bir terminal sekmesinde/penceresinde çalıştırılacak komutu tanımlar
+type TerminalRequest struct {
+	Title   string   // Sekme başlığı
+	Dir     string   // Çalışma dizini
+	Command string   // Kabukta çalıştırılacak komut satırı
+	Env     []string // Ek ortam değişkenleri (KEY=VALUE)
+}
+
+// TerminalCommand bir backend'in üreteceği tek bir süreç çağrısıdır
+type TerminalCommand struct {
+	Args []string
+	Dir  string // Sadece backend sürecin kendisini dizinde başlatıyorsa dolu
+}
+
+// TerminalBackend komutları harici bir terminal emülatöründe açar
+type TerminalBackend interface {
+	// Name config'de kullanılan backend adını döndürür
+	Name() string
+	// Available backend'in bu sistemde kullanılabilir olup olmadığını söyler
+	Available() bool
+	// Commands istekleri açacak süreç çağrılarını üretir.
+	// İlk istek yeni sekme olarak, diğerleri destekleniyorsa bölünmüş panel olarak açılır.
+	Commands(reqs []TerminalRequest) []TerminalCommand
+}
+
+// Desteklenen backend adları
+const (
+	TerminalAuto          = "auto"
+	TerminalWindows       = "wt"
+	TerminalTmux          = "tmux"
+	TerminalKitty         = "kitty"
+	TerminalWezTerm       = "wezterm"
+	TerminalGnomeTerminal = "gnome-terminal"
+	TerminalKonsole       = "konsole"
+	TerminalDetached      = "detached"
+)
+
+// NewTerminalBackend config'deki isme göre backend seçer, "auto" veya boş ise ortamdan tespit eder
+func NewTerminalBackend(name string) TerminalBackend {
+	switch strings.ToLower(strings.TrimSpace(name)) {
+	case TerminalWindows, "wt.exe", "windows-terminal":
+		return windowsTerminal{}
+	case TerminalTmux:
+		return tmuxTerminal{}
+	case TerminalKitty:
+		return kittyTerminal{}
+	case TerminalWezTerm:
+		return wezTerminal{}
+	case TerminalGnomeTerminal, "gnome":
+		return gnomeTerminal{}
+	case TerminalKonsole:
+		return konsoleTerminal{}
+	case TerminalDetached:
+		return detachedTerminal{}
+	}
+	return DetectTerminalBackend()
+}
+
+// DetectTerminalBackend çalışılan terminali ortam değişkenlerinden, bulunamazsa PATH'ten tespit eder
+func DetectTerminalBackend() TerminalBackend {
+	if runtime.GOOS == "windows" {
+		if (windowsTerminal{}).Available() {
+			return windowsTerminal{}
+		}
+		return detachedTerminal{}
+	}
+
+	// 1. İçinde bulunduğumuz terminal (en doğal sonuç bu olur)
+	switch {
+	case os.Getenv("TMUX") != "":
+		return tmuxTerminal{}
+	case os.Getenv("KITTY_WINDOW_ID") != "":
+		return kittyTerminal{}
+	case os.Getenv("WEZTERM_PANE") != "" || os.Getenv("TERM_PROGRAM") == "WezTerm":
+		return wezTerminal{}
+	case os.Getenv("KONSOLE_VERSION") != "":
+		return konsoleTerminal{}
+	case os.Getenv("GNOME_TERMINAL_SCREEN") != "" || os.Getenv("VTE_VERSION") != "":
+		if (gnomeTerminal{}).Available() {
+			return gnomeTerminal{}
+		}
+	}
+
+	// 2. Kurulu herhangi bir emülatör
+	for _, b := range []TerminalBackend{gnomeTerminal{}, konsoleTerminal{}, kittyTerminal{}, wezTerminal{}} {
+		if b.Available() {
+			return b
+		}
+	}
+
+	// 3. Terminal yok, süreci arka planda başlat
+	return detachedTerminal{}
+}
+
+// openInTerminal istekleri backend üzerinden başlatır
+func openInTerminal(b TerminalBackend, reqs ...TerminalRequest) error {
+	if len(reqs) == 0 {
+		return fmt.Errorf("empty command")
+	}
+	// Çağıranın dilimi (geçmiş kaydı, tekrar çalıştırma) sarmalanmamış kalmalı
+	reqs = append([]TerminalRequest(nil), reqs...)
+	for i := range reqs {
+		reqs[i].Command = envCommand(reqs[i].Env, reqs[i].Command)
+	}
+	for _, tc := range b.Commands(reqs) {
+		if len(tc.Args) == 0 {
+			return fmt.Errorf("failed to create command")
+		}
+		c := exec.Command(tc.Args[0], tc.Args[1:]...)
+		c.Dir = tc.Dir
+		if err := c.Start(); err != nil {
+			return fmt.Errorf("%s başlatılamadı: %w", b.Name(), err)
+		}
+		// Süreci bekleyip zombie kalmasını engelle
+		go c.Wait()
+	}
+	return nil
+}
+
+// userShell Unix sistemlerde kullanıcının kabuğunu döndürür
+func userShell() string {
+	if sh := os.Getenv("SHELL"); sh != "" {
+		return sh
+	}
+	return "sh"
+}
+
+// keepOpenArgs komutu çalıştırıp sonrasında kabuğu açık tutan argv üretir (cmd /k benzeri)
+func keepOpenArgs(command string) []string {
+	if runtime.GOOS == "windows" {
+		return []string{"cmd", "/k", command}
+	}
+	sh := userShell()
+	return []string{sh, "-c", command + "; exec " + sh}
+}
+
+// shellArgs komutu tek seferlik çalıştıran argv üretir
+func shellArgs(command string) []string {
+	if runtime.GOOS == "windows" {
+		return []string{"cmd", "/c", command}
+	}
+	return []string{"sh", "-c", command}
+}
+
+// quoteShellArg bir argümanı platformun kabuğuna uygun şekilde tırnaklar
+func quoteShellArg(s string) string {
+	if runtime.GOOS == "windows" {
+		return `"` + strings.ReplaceAll(s, `"`, `\"`) + `"`
+	}
+	if s != "" && !strings.ContainsAny(s, " \t\n'\"\\$`!*?&;|<>(){}[]#~") {
+		return s
+	}
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// envCommand ortam değişkenlerini komutun başına ekler
+// (terminal backend'lerine ayrıca ortam aktarılamadığı için)
+func envCommand(env []string, cmd string) string {
+	if len(env) == 0 {
+		return cmd
+	}
+	var parts []string
+	for _, kv := range env {
+		if runtime.GOOS == "windows" {
+			parts = append(parts, `set "`+kv+`"&&`)
+			continue
+		}
+		k, v, _ := strings.Cut(kv, "=")
+		parts = append(parts, k+"="+quoteShellArg(v))
+	}
+	if runtime.GOOS == "windows" {
+		return strings.Join(parts, " ") + " " + cmd
+	}
+	return "export " + strings.Join(parts, " ") + "; " + cmd
+}
+
+// --- Windows Terminal ---
+
+type windowsTerminal struct{}
+
+func (windowsTerminal) Name() string { return TerminalWindows }
+
+func (windowsTerminal) Available() bool {
+	_, err := exec.LookPath("wt")
+	return err == nil
+}
+
+func (windowsTerminal) Commands(reqs []TerminalRequest) []TerminalCommand {
+	args := []string{"wt", "-w", "0"}
+	for i, r := range reqs {
+		if i == 0 {
+			args = append(args, "nt")
+		} else {
+			args = append(args, ";", "split-pane")
+		}
+		if r.Title != "" {
+			args = append(args, "--title", r.Title)
+		}
+		args = append(args, "-d", r.Dir)
+		args = append(args, keepOpenArgs(r.Command)...)
+	}
+	return []TerminalCommand{{Args: args}}
+}
+
+// --- tmux ---
+
+type tmuxTerminal struct{}
+
+// tmuxSession tmux dışından açılan pencerelerin toplandığı oturum ("tmux attach -t devterminal")
+const tmuxSession = "devterminal"
+
+func (tmuxTerminal) Name() string { return TerminalTmux }
+
+// Available sadece tmux'un kurulu olmasına bakar; "auto" seçimi ayrıca $TMUX ister
+func (tmuxTerminal) Available() bool {
+	_, err := exec.LookPath("tmux")
+	return err == nil
+}
+
+func (tmuxTerminal) Commands(reqs []TerminalRequest) []TerminalCommand {
+	// tmux içinde değilsek pencereler arka plandaki oturuma açılır (yoksa oluşturulur)
+	inside := os.Getenv("TMUX") != ""
+	sessionExists := !inside && exec.Command("tmux", "has-session", "-t", tmuxSession).Run() == nil
+
+	args := []string{"tmux"}
+	for i, r := range reqs {
+		switch {
+		case i > 0:
+			args = append(args, ";", "split-window", "-h")
+			if !inside {
+				args = append(args, "-t", tmuxSession)
+			}
+		case inside:
+			args = append(args, "new-window")
+		case sessionExists:
+			args = append(args, "new-window", "-t", tmuxSession+":")
+		default:
+			args = append(args, "new-session", "-d", "-s", tmuxSession)
+		}
+		if i == 0 && r.Title != "" {
+			args = append(args, "-n", r.Title)
+		}
+		sh := userShell()
+		args = append(args, "-c", r.Dir, r.Command+"; exec "+sh)
+	}
+	return []TerminalCommand{{Args: args}}
+}
+
+// --- kitty ---
+
+type kittyTerminal struct{}
+
+func (kittyTerminal) Name() string { return TerminalKitty }
+
+func (kittyTerminal) Available() bool {
+	_, err := exec.LookPath("kitty")
+	return err == nil
+}
+
+func (kittyTerminal) Commands(reqs []TerminalRequest) []TerminalCommand {
+	var cmds []TerminalCommand
+	for _, r := range reqs {
+		args := []string{"kitty", "--detach", "--directory", r.Dir}
+		if r.Title != "" {
+			args = append(args, "--title", r.Title)
+		}
+		args = append(args, keepOpenArgs(r.Command)...)
+		cmds = append(cmds, TerminalCommand{Args: args})
+	}
+	return cmds
+}
+
+// --- WezTerm ---
+
+type wezTerminal struct{}
+
+func (wezTerminal) Name() string { return TerminalWezTerm }
+
+func (wezTerminal) Available() bool {
+	_, err := exec.LookPath("wezterm")
+	return err == nil
+}
+
+func (wezTerminal) Commands(reqs []TerminalRequest) []TerminalCommand {
+	var cmds []TerminalCommand
+	for _, r := range reqs {
+		// WezTerm içindeysek mevcut pencereye sekme ekle, değilsek yeni pencere aç
+		args := []string{"wezterm", "start", "--cwd", r.Dir, "--"}
+		if os.Getenv("WEZTERM_PANE") != "" {
+			args = []string{"wezterm", "cli", "spawn", "--cwd", r.Dir, "--"}
+		}
+		args = append(args, keepOpenArgs(r.Command)...)
+		cmds = append(cmds, TerminalCommand{Args: args})
+	}
+	return cmds
+}
+
+// --- GNOME Terminal ---
+
+type gnomeTerminal struct{}
+
+func (gnomeTerminal) Name() string { return TerminalGnomeTerminal }
+
+func (gnomeTerminal) Available() bool {
+	_, err := exec.LookPath("gnome-terminal")
+	return err == nil
+}
+
+func (gnomeTerminal) Commands(reqs []TerminalRequest) []TerminalCommand {
+	var cmds []TerminalCommand
+	for _, r := range reqs {
+		args := []string{"gnome-terminal", "--tab", "--working-directory=" + r.Dir}
+		if r.Title != "" {
+			args = append(args, "--title="+r.Title)
+		}
+		args = append(args, "--")
+		args = append(args, keepOpenArgs(r.Command)...)
+		cmds = append(cmds, TerminalCommand{Args: args})
+	}
+	return cmds
+}
+
+// --- Konsole ---
+
+type konsoleTerminal struct{}
+
+func (konsoleTerminal) Name() string { return TerminalKonsole }
+
+func (konsoleTerminal) Available() bool {
+	_, err := exec.LookPath("konsole")
+	return err == nil
+}
+
+func (konsoleTerminal) Commands(reqs []TerminalRequest) []TerminalCommand {
+	var cmds []TerminalCommand
+	for _, r := range reqs {
+		args := []string{"konsole", "--new-tab", "--workdir", r.Dir}
+		if r.Title != "" {
+			args = append(args, "-p", "tabtitle="+r.Title)
+		}
+		args = append(args, "-e")
+		args = append(args, keepOpenArgs(r.Command)...)
+		cmds = append(cmds, TerminalCommand{Args: args})
+	}
+	return cmds
+}
+
+// --- Detached (terminal olmadan) ---
+
+// detachedTerminal komutu terminal açmadan arka planda başlatır (son çare)
+type detachedTerminal struct{}
+
+func (detachedTerminal) Name() string { return TerminalDetached }
+
+func (detachedTerminal) Available() bool { return true }
+
+func (detachedTerminal) Commands(reqs []TerminalRequest) []TerminalCommand {
+	var cmds []TerminalCommand
+	for _, r := range reqs {
+		cmds = append(cmds, TerminalCommand{Args: shellArgs(r.Command), Dir: r.Dir})
+	}
+	return cmds
+}
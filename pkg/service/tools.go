@@ -0,0 +1,321 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"devterminal/pkg/domain"
+)
+
+// builtinTools varsayılan araç kaydıdır; config'teki "tools" girdileri aynı id ile bunları ezer
+var builtinTools = []domain.ToolDef{
+	{
+		ID: "prisma", Name: "Prisma Studio", Icon: "◮", Key: "f1",
+		Command: "{{.PackageExec}} prisma studio",
+		Dir:     domain.ToolDirPackage, // prisma/schema.prisma -> package.json'ın olduğu dizin
+		Detect:  domain.ToolDetect{Files: []string{"schema.prisma"}, Dependencies: []string{"prisma", "@prisma/client"}},
+	},
+	{
+		ID: "drizzle", Name: "Drizzle Studio", Icon: "🌧️", Key: "f2",
+		Command: "{{.PackageExec}} drizzle-kit studio",
+		Detect:  domain.ToolDetect{Files: []string{"drizzle.config.*"}, Dependencies: []string{"drizzle-orm", "drizzle-kit"}},
+	},
+	{
+		ID: "hasura", Name: "Hasura Console", Icon: "🦅", Key: "f3",
+		Command: "hasura console",
+		Detect:  domain.ToolDetect{Dirs: []string{"hasura"}}, // hasura klasörünün üst dizininden çalışır
+	},
+	{
+		ID: "supabase", Name: "Supabase Status", Icon: "⚡", Key: "f4",
+		Command: "npx supabase status",
+		Detect:  domain.ToolDetect{Dirs: []string{"supabase"}},
+	},
+	{
+		ID: "storybook", Name: "Storybook (UI Dev)", Icon: "📕", Key: "f5",
+		Command: "{{.PackageManager}} run storybook",
+		Dir:     domain.ToolDirPackage,
+		Detect:  domain.ToolDetect{Dirs: []string{".storybook"}, Dependencies: []string{"storybook", "@storybook/*"}},
+	},
+	{
+		ID: "playwright", Name: "Playwright UI", Icon: "🎭", Key: "f6",
+		Command: "{{.PackageExec}} playwright test --ui",
+		Dir:     domain.ToolDirPackage,
+		Detect:  domain.ToolDetect{Files: []string{"playwright.config.*"}, Dependencies: []string{"@playwright/test"}},
+	},
+	{
+		ID: "vitest", Name: "Vitest UI", Icon: "🧪", Key: "f7",
+		Command: "{{.PackageExec}} vitest --ui",
+		Dir:     domain.ToolDirPackage,
+		Detect:  domain.ToolDetect{Dependencies: []string{"@vitest/ui"}},
+	},
+}
+
+// reservedToolKeys proje menüsünün sabit kısayollarıdır (ui.Model'deki StateProjectActions);
+// bu tuşlara atanan araçlar hiç çalıştırılamayacağı için kabul edilmez
+var reservedToolKeys = map[string]bool{
+	"0": true, "1": true, "2": true, "3": true, "4": true, "5": true, "6": true, "7": true, "8": true, "9": true,
+	"a": true, "b": true, "c": true, "e": true, "f": true, "g": true, "h": true, "i": true, "l": true,
+	"m": true, "o": true, "p": true, "q": true, "r": true, "s": true, "t": true, "v": true,
+	"esc": true, "enter": true, "ctrl+c": true,
+}
+
+// ToolRegistry yerleşik araçları config'teki "tools" girdileriyle birleştirir.
+// Aynı id'li girdi yerleşik aracın sadece verilen alanlarını ezer, disabled aracı kaldırır.
+// Ayrılmış veya başka araçta kullanılan tuşlar boştaki ilk F tuşuyla değiştirilir;
+// bu araçlar için uyarılar id'ye göre döner.
+func ToolRegistry(cfg *domain.Config) ([]domain.ToolDef, map[string]string) {
+	tools := append([]domain.ToolDef(nil), builtinTools...)
+	for _, t := range cfg.Tools {
+		if t.ID == "" {
+			t.ID = strings.ToLower(strings.ReplaceAll(strings.TrimSpace(t.Name), " ", "-"))
+		}
+		if t.ID == "" {
+			continue
+		}
+		replaced := false
+		for i := range tools {
+			if tools[i].ID == t.ID {
+				tools[i] = mergeTool(tools[i], t)
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			tools = append(tools, t)
+		}
+	}
+
+	var out []domain.ToolDef
+	warnings := make(map[string]string)
+	used := make(map[string]bool)
+	for _, t := range tools {
+		if t.Disabled || strings.TrimSpace(t.Command) == "" {
+			continue
+		}
+		if t.Name == "" {
+			t.Name = t.ID
+		}
+		if t.Icon == "" {
+			t.Icon = "🛠️"
+		}
+		t.Key = strings.ToLower(strings.TrimSpace(t.Key))
+		switch {
+		case t.Key == "":
+		case reservedToolKeys[t.Key]:
+			warnings[t.ID] = fmt.Sprintf("%s: %q tuşu proje menüsünde kullanılıyor", t.Name, t.Key)
+			t.Key = ""
+		case used[t.Key]:
+			warnings[t.ID] = fmt.Sprintf("%s: %q tuşu başka bir araçta kullanılıyor", t.Name, t.Key)
+			t.Key = ""
+		default:
+			used[t.Key] = true
+		}
+		out = append(out, t)
+	}
+
+	// Tuşu olmayan araçlara boştaki ilk F tuşunu ver
+	for i := range out {
+		if out[i].Key != "" {
+			continue
+		}
+		for n := 1; n <= 12; n++ {
+			if k := fmt.Sprintf("f%d", n); !used[k] {
+				out[i].Key, used[k] = k, true
+				break
+			}
+		}
+		if out[i].Key == "" {
+			warnings[out[i].ID] = out[i].Name + ": boşta F tuşu kalmadı"
+		} else if w, ok := warnings[out[i].ID]; ok {
+			warnings[out[i].ID] = w + ", " + strings.ToUpper(out[i].Key) + " kullanılıyor"
+		}
+	}
+	return out, warnings
+}
+
+// mergeTool config girdisinde dolu olan alanları yerleşik araca uygular
+func mergeTool(base, o domain.ToolDef) domain.ToolDef {
+	if o.Name != "" {
+		base.Name = o.Name
+	}
+	if o.Icon != "" {
+		base.Icon = o.Icon
+	}
+	if o.Key != "" {
+		base.Key = o.Key
+	}
+	if o.Command != "" {
+		base.Command = o.Command
+	}
+	if o.Dir != "" {
+		base.Dir = o.Dir
+	}
+	if !o.Detect.IsZero() {
+		base.Detect = o.Detect
+	}
+	base.Disabled = o.Disabled
+	return base
+}
+
+// checkTools araç kaydındaki tespit kurallarına göre projedeki araçları bulur
+func (s *Scanner) checkTools(root string, p *domain.Project) {
+	p.Tools, p.ToolWarnings = nil, nil
+	tools, warnings := ToolRegistry(s.Config)
+	dirs := make([]string, len(tools)) // Bulunan araçların çalışma dizini
+
+	// Gizli klasörler atlanır; kuralda adı geçenler hariç (.storybook gibi)
+	allowHidden := map[string]bool{".config": true}
+	for _, t := range tools {
+		for _, d := range t.Detect.Dirs {
+			allowHidden[d] = true
+		}
+	}
+
+	_ = filepath.WalkDir(root, func(fPath string, d os.DirEntry, err error) error {
+		if err != nil {
+			return nil
+		}
+
+		name := d.Name()
+
+		// Skip heavy directories
+		if d.IsDir() && fPath != root {
+			if name == "node_modules" || name == ".git" || name == "vendor" || name == "dist" || name == "build" || name == ".next" {
+				return filepath.SkipDir
+			}
+			if strings.HasPrefix(name, ".") && !allowHidden[name] {
+				return filepath.SkipDir
+			}
+		}
+
+		// Depth check (max 3 levels)
+		rel, _ := filepath.Rel(root, fPath)
+		depth := strings.Count(rel, string(os.PathSeparator))
+		if depth > 3 {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if fPath == root {
+			return nil
+		}
+
+		dir := filepath.Dir(fPath)
+		var deps []string
+		if !d.IsDir() && name == "package.json" {
+			deps = packageDependencies(fPath)
+		}
+
+		for i, t := range tools {
+			if dirs[i] != "" {
+				continue
+			}
+			matched := false
+			if d.IsDir() {
+				matched = containsName(t.Detect.Dirs, name)
+			} else {
+				matched = matchesAnyGlob(t.Detect.Files, name) || dependencyMatches(t.Detect.Dependencies, deps)
+			}
+			if matched {
+				dirs[i] = toolDir(t.Dir, root, dir)
+			}
+		}
+		return nil
+	})
+
+	for i, t := range tools {
+		// Kuralı olmayan araçlar (örn: pgweb) her projede gösterilir
+		if t.Detect.IsZero() {
+			dirs[i] = toolDir(t.Dir, root, root)
+		}
+		if dirs[i] == "" {
+			continue
+		}
+		// Tuş uyarıları sadece projede bulunan araçlar için gösterilir
+		if w, ok := warnings[t.ID]; ok {
+			p.ToolWarnings = append(p.ToolWarnings, w)
+		}
+		if t.Key == "" {
+			continue
+		}
+		p.Tools = append(p.Tools, domain.ProjectTool{
+			ID: t.ID, Name: t.Name, Icon: t.Icon, Key: t.Key, Command: t.Command, Dir: dirs[i],
+		})
+	}
+}
+
+// toolDir çalışma dizini kuralını eşleşmenin bulunduğu dizine uygular
+func toolDir(rule domain.ToolDirRule, root, dir string) string {
+	switch rule {
+	case domain.ToolDirRoot:
+		return root
+	case domain.ToolDirPackage:
+		// Proje köküne kadar yukarı çıkarak package.json ara
+		for d := dir; ; d = filepath.Dir(d) {
+			if _, err := os.Stat(filepath.Join(d, "package.json")); err == nil {
+				return d
+			}
+			if d == root || d == filepath.Dir(d) || !strings.HasPrefix(d, root) {
+				break
+			}
+		}
+	}
+	return dir
+}
+
+// packageDependencies package.json'daki tüm bağımlılık adlarını döndürür
+func packageDependencies(path string) []string {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	var pkg packageJSON
+	if json.Unmarshal(data, &pkg) != nil {
+		return nil
+	}
+	deps := make([]string, 0, len(pkg.Dependencies)+len(pkg.DevDependencies))
+	for dep := range pkg.Dependencies {
+		deps = append(deps, dep)
+	}
+	for dep := range pkg.DevDependencies {
+		deps = append(deps, dep)
+	}
+	return deps
+}
+
+// dependencyMatches bağımlılıklardan biri desenlerden birine uyuyor mu ("@storybook/*" gibi)
+func dependencyMatches(patterns, deps []string) bool {
+	for _, pattern := range patterns {
+		for _, dep := range deps {
+			if ok, _ := path.Match(pattern, dep); ok || dep == pattern {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matchesAnyGlob dosya adı glob'lardan birine uyuyor mu
+func matchesAnyGlob(patterns []string, name string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := filepath.Match(pattern, name); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// containsName klasör adı listede var mı
+func containsName(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,467 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"devterminal/pkg/domain"
+)
+
+// UpgradeTarget seçili paketlerin yükseltileceği sürüm
+type UpgradeTarget string
+
+const (
+	UpgradeWanted UpgradeTarget = "wanted" // Manifestteki aralığın izin verdiği en yüksek sürüm
+	UpgradeLatest UpgradeTarget = "latest" // Kayıt defterindeki son sürüm (major değişebilir)
+)
+
+// UpgradeChange manifestte değişecek tek bir bağımlılık tanımıdır
+type UpgradeChange struct {
+	Name string
+	From string // Eski tanım (örn: "^1.2.0")
+	To   string // Yeni tanım (örn: "^1.4.2")
+}
+
+// UpgradePlan tek bir manifestteki yükseltmelerdir
+type UpgradePlan struct {
+	Ecosystem string
+	Dir       string
+	Manifest  string   // package.json veya go.mod
+	Files     []string // Başarısızlıkta geri yüklenecek dosyalar (manifest + kilit dosyası)
+	Changes   []UpgradeChange
+	Diff      []string // Manifestin değişen satırları ("- eski" / "+ yeni")
+	updated   []byte
+}
+
+// Upgrade onaylanmayı bekleyen yükseltmedir. Units sırayla çalışır:
+// önce kurulum, sonra doğrulama; Units[Rollback:] sadece hata olursa çalışan geri alma adımlarıdır.
+type Upgrade struct {
+	Target   UpgradeTarget
+	Plans    []UpgradePlan
+	Skipped  []string // Yükseltilemeyen paketler ve nedeni
+	Units    []PipelineUnit
+	Verify   []string // Doğrulama komutları (önizlemede gösterilir)
+	Rollback int
+	project  string
+}
+
+// PlanUpgrade seçilen bağımlılıklar için manifest değişikliklerini ve çalışacak komutları hazırlar.
+// Dosyalara dokunulmaz; değişiklikler RunUpgrade ile uygulanır.
+func (d *Doctor) PlanUpgrade(p *domain.Project, deps []domain.OutdatedDependency, target UpgradeTarget) (*Upgrade, error) {
+	u := &Upgrade{Target: target, project: p.Path}
+
+	// Aynı manifestteki paketler tek planda toplanır
+	type group struct {
+		eco  string
+		dir  string
+		deps []domain.OutdatedDependency
+	}
+	var groups []*group
+	byKey := make(map[string]*group)
+	for _, dep := range deps {
+		key := dep.Dir + "\x00" + dep.Ecosystem
+		g, ok := byKey[key]
+		if !ok {
+			g = &group{eco: dep.Ecosystem, dir: dep.Dir}
+			byKey[key] = g
+			groups = append(groups, g)
+		}
+		g.deps = append(g.deps, dep)
+	}
+
+	var installs, verifies []PipelineUnit
+	seen := make(map[string]bool)
+	addUnit := func(list *[]PipelineUnit, unit PipelineUnit) {
+		if key := unit.Dir + "\x00" + unit.Command; !seen[key] {
+			seen[key] = true
+			*list = append(*list, unit)
+		}
+	}
+
+	for _, g := range groups {
+		var plan *UpgradePlan
+		var install PipelineUnit
+		var skipped []string
+		var err error
+		switch g.eco {
+		case "npm", "pnpm", "yarn", "bun":
+			plan, install, skipped, err = planJSUpgrade(g.dir, g.deps, target)
+		case "go":
+			plan, install, skipped, err = planGoUpgrade(g.dir, g.deps, target)
+		default:
+			for _, dep := range g.deps {
+				u.Skipped = append(u.Skipped, fmt.Sprintf("%s: %s yükseltmesi desteklenmiyor", dep.Name, g.eco))
+			}
+			continue
+		}
+		u.Skipped = append(u.Skipped, skipped...)
+		if err != nil {
+			u.Skipped = append(u.Skipped, fmt.Sprintf("%s: %v", g.eco, err))
+			continue
+		}
+		if plan == nil {
+			continue
+		}
+		plan.Ecosystem = g.eco
+		u.Plans = append(u.Plans, *plan)
+
+		install.Name = unitName(p, install.Dir, install.Command)
+		addUnit(&installs, install)
+		if cmd := d.verifyCommand(p, g.eco, g.dir); cmd != "" {
+			addUnit(&verifies, PipelineUnit{Name: unitName(p, g.dir, cmd), Dir: g.dir, Command: cmd})
+		}
+	}
+	if len(u.Plans) == 0 {
+		if len(u.Skipped) > 0 {
+			return nil, fmt.Errorf("yükseltilecek paket yok (%s)", strings.Join(u.Skipped, "; "))
+		}
+		return nil, fmt.Errorf("yükseltilecek paket yok")
+	}
+
+	u.Units = append(installs, verifies...)
+	for _, v := range verifies {
+		u.Verify = append(u.Verify, v.Command)
+	}
+	// Geri alma: dosyalar eski haline döndükten sonra kurulum tekrar çalıştırılır
+	u.Rollback = len(u.Units)
+	for _, in := range installs {
+		u.Units = append(u.Units, PipelineUnit{Name: "↩ " + in.Name, Dir: in.Dir, Command: in.Command})
+	}
+	for i := range u.Units {
+		u.Units[i].Group = i
+	}
+	return u, nil
+}
+
+// unitName komutu, proje kökü dışındaysa klasörüyle birlikte gösterir
+func unitName(p *domain.Project, dir, cmd string) string {
+	if rel, err := filepath.Rel(p.Path, dir); err == nil && rel != "." {
+		return cmd + " (" + filepath.ToSlash(rel) + ")"
+	}
+	return cmd
+}
+
+// verifyCommand yükseltmeden sonra çalışacak doğrulama komutunu bulur.
+// Sıra: proje ayarı (verify), global doctor.verify, package.json "test" scripti, Go için "go build ./...".
+// Ayardaki değer package.json'da bir script adıysa paket yöneticisiyle çalıştırılır.
+func (d *Doctor) verifyCommand(p *domain.Project, eco, dir string) string {
+	configured := d.Config.Doctor.Verify
+	if v := d.Config.ProjectOverrides[strings.ToLower(p.Path)].Verify; v != "" {
+		configured = v
+	}
+	if configured == "-" {
+		return "" // Doğrulama kapalı
+	}
+
+	if eco == "go" {
+		if configured != "" {
+			return configured
+		}
+		return "go build ./..."
+	}
+
+	var pkg packageJSON
+	if data, err := os.ReadFile(filepath.Join(dir, "package.json")); err == nil {
+		_ = json.Unmarshal(data, &pkg)
+	}
+	pm := ResolvePackageManager(dir)
+	if configured != "" {
+		if _, ok := pkg.Scripts[configured]; ok {
+			return commandLine(pm.Run(configured))
+		}
+		return configured
+	}
+	// npm init'in yer tutucu test scripti her zaman başarısız olur
+	if test, ok := pkg.Scripts["test"]; ok && !strings.Contains(test, "no test specified") {
+		return commandLine(pm.Run("test"))
+	}
+	return ""
+}
+
+// --- package.json ---
+
+// specVersionRe tek koşullu aralığın operatörünü ayırır ("^1.2.0" -> "^")
+var specVersionRe = regexp.MustCompile(`^(\^|~|>=|=)?v?\d+(\.\d+|\.[xX*])*(-[0-9A-Za-z.-]+)?$`)
+
+func planJSUpgrade(dir string, deps []domain.OutdatedDependency, target UpgradeTarget) (*UpgradePlan, PipelineUnit, []string, error) {
+	manifest := filepath.Join(dir, "package.json")
+	data, err := os.ReadFile(manifest)
+	if err != nil {
+		return nil, PipelineUnit{}, nil, fmt.Errorf("package.json okunamadı: %v", err)
+	}
+
+	plan := &UpgradePlan{Dir: dir, Manifest: manifest, Files: []string{manifest}}
+	updated := data
+	var skipped []string
+	for _, dep := range deps {
+		to := dep.Latest
+		if target == UpgradeWanted {
+			to = dep.Wanted
+		}
+		if !isSemver(to) || to == dep.Current {
+			skipped = append(skipped, fmt.Sprintf("%s: hedef sürüm yok", dep.Name))
+			continue
+		}
+
+		sections := []string{"dependencies", "optionalDependencies", "devDependencies"}
+		if dep.Dev {
+			sections = []string{"devDependencies", "dependencies", "optionalDependencies"}
+		}
+		found := false
+		for _, section := range sections {
+			var spec string
+			out, from, ok := replacePackageSpec(updated, section, dep.Name, func(old string) (string, bool) {
+				s, ok := bumpSpec(old, to)
+				spec = s
+				return s, ok && s != old
+			})
+			if from == "" {
+				continue // Bu bölümde yok
+			}
+			found = true
+			switch {
+			case spec == from:
+				skipped = append(skipped, fmt.Sprintf("%s: manifest zaten %s", dep.Name, from))
+			case !ok:
+				skipped = append(skipped, fmt.Sprintf("%s: %q tanımı otomatik güncellenemez", dep.Name, from))
+			default:
+				updated = out
+				plan.Changes = append(plan.Changes, UpgradeChange{Name: dep.Name, From: from, To: spec})
+			}
+			break
+		}
+		if !found {
+			skipped = append(skipped, fmt.Sprintf("%s: package.json'da bulunamadı", dep.Name))
+		}
+	}
+	if len(plan.Changes) == 0 {
+		return nil, PipelineUnit{}, skipped, nil
+	}
+	plan.updated = updated
+	plan.Diff = lineDiff(data, updated)
+
+	// Kurulum workspace kökünde çalışır ve kilit dosyasını günceller
+	pm := ResolvePackageManager(dir)
+	installDir := dir
+	if pm.Source != "default" {
+		installDir = pm.Root
+	}
+	if pm.Lockfile != "" {
+		plan.Files = append(plan.Files, filepath.Join(pm.Root, pm.Lockfile))
+	}
+	return plan, PipelineUnit{Dir: installDir, Command: commandLine(pm.Install())}, skipped, nil
+}
+
+// replacePackageSpec package.json'ın verilen bölümündeki bağımlılık tanımını değiştirir.
+// Biçimlendirme korunur. Paket bölümde yoksa eski tanım boş döner.
+func replacePackageSpec(data []byte, section, name string, edit func(old string) (string, bool)) ([]byte, string, bool) {
+	loc := regexp.MustCompile(`"` + regexp.QuoteMeta(section) + `"\s*:\s*\{`).FindIndex(data)
+	if loc == nil {
+		return data, "", false
+	}
+	end := bytes.IndexByte(data[loc[1]:], '}') // Bağımlılık bölümleri iç içe nesne içermez
+	if end < 0 {
+		return data, "", false
+	}
+	body := data[loc[1] : loc[1]+end]
+	m := regexp.MustCompile(`"` + regexp.QuoteMeta(name) + `"\s*:\s*"([^"]*)"`).FindSubmatchIndex(body)
+	if m == nil {
+		return data, "", false
+	}
+	old := string(body[m[2]:m[3]])
+	spec, ok := edit(old)
+	if !ok {
+		return data, old, false
+	}
+	start, stop := loc[1]+m[2], loc[1]+m[3]
+	out := make([]byte, 0, len(data)+len(spec))
+	out = append(out, data[:start]...)
+	out = append(out, spec...)
+	out = append(out, data[stop:]...)
+	return out, old, true
+}
+
+// bumpSpec aralığın operatörünü koruyarak yeni sürümü yazar ("^1.2.0" -> "^1.4.2", "npm:x@~1.0.0" -> "npm:x@~1.1.0").
+// Birden çok koşullu aralıklar, dist-tag'ler ve git/dosya tanımları değiştirilmez.
+func bumpSpec(spec, to string) (string, bool) {
+	prefix, rng := "", strings.TrimSpace(spec)
+	if strings.HasPrefix(rng, "npm:") {
+		i := strings.LastIndex(rng, "@")
+		if i <= len("npm:") {
+			return "", false
+		}
+		prefix, rng = rng[:i+1], rng[i+1:]
+	}
+	m := specVersionRe.FindStringSubmatch(rng)
+	if m == nil {
+		return "", false
+	}
+	return prefix + m[1] + to, true
+}
+
+// --- go.mod ---
+
+func planGoUpgrade(dir string, deps []domain.OutdatedDependency, target UpgradeTarget) (*UpgradePlan, PipelineUnit, []string, error) {
+	manifest := filepath.Join(dir, "go.mod")
+	data, err := os.ReadFile(manifest)
+	if err != nil {
+		return nil, PipelineUnit{}, nil, fmt.Errorf("go.mod okunamadı: %v", err)
+	}
+
+	plan := &UpgradePlan{Dir: dir, Manifest: manifest, Files: []string{manifest, filepath.Join(dir, "go.sum")}}
+	lines := strings.Split(string(data), "\n")
+	var skipped []string
+	for _, dep := range deps {
+		to := dep.Latest
+		if target == UpgradeWanted && dep.Wanted != "" {
+			to = dep.Wanted
+		}
+		if to == "" || to == dep.Current {
+			skipped = append(skipped, fmt.Sprintf("%s: hedef sürüm yok", dep.Name))
+			continue
+		}
+		if !setGoRequire(lines, dep.Name, to) {
+			skipped = append(skipped, fmt.Sprintf("%s: go.mod'da doğrudan gerekmiyor", dep.Name))
+			continue
+		}
+		plan.Changes = append(plan.Changes, UpgradeChange{Name: dep.Name, From: dep.Current, To: to})
+	}
+	if len(plan.Changes) == 0 {
+		return nil, PipelineUnit{}, skipped, nil
+	}
+	plan.updated = []byte(strings.Join(lines, "\n"))
+	plan.Diff = lineDiff(data, plan.updated)
+	// tidy yeni sürümlerin bağımlılıklarını çözer ve go.sum'ı günceller
+	return plan, PipelineUnit{Dir: dir, Command: "go mod tidy"}, skipped, nil
+}
+
+// setGoRequire require satırındaki sürümü değiştirir (tek satır ve blok biçimi); replace satırlarına dokunmaz
+func setGoRequire(lines []string, path, version string) bool {
+	inBlock := false
+	for i, line := range lines {
+		fields := strings.Fields(line)
+		switch {
+		case len(fields) == 0:
+			continue
+		case fields[0] == "require" && len(fields) > 1 && fields[1] == "(":
+			inBlock = true
+			continue
+		case inBlock && fields[0] == ")":
+			inBlock = false
+			continue
+		case fields[0] == "require":
+			fields = fields[1:]
+		case !inBlock:
+			continue
+		}
+		if len(fields) < 2 || fields[0] != path {
+			continue
+		}
+		idx := strings.Index(line, path) + len(path)
+		lines[i] = line[:idx] + strings.Replace(line[idx:], fields[1], version, 1)
+		return true
+	}
+	return false
+}
+
+// lineDiff aynı sayıda satırı olan iki metnin değişen satırlarını döndürür
+func lineDiff(before, after []byte) []string {
+	a := strings.Split(string(before), "\n")
+	b := strings.Split(string(after), "\n")
+	var diff []string
+	for i := 0; i < len(a) && i < len(b); i++ {
+		if a[i] != b[i] {
+			diff = append(diff, "- "+strings.TrimSpace(a[i]), "+ "+strings.TrimSpace(b[i]))
+		}
+	}
+	return diff
+}
+
+// --- çalıştırma ---
+
+// RunUpgrade manifestleri yazar, kurulum ve doğrulama adımlarını sırayla çalıştırır.
+// Bir adım başarısız olursa (veya iptal edilirse) manifest ve kilit dosyaları eski haline
+// döndürülür ve kurulum tekrar çalıştırılır. Olaylar events kanalına yazılır ve iş bitince kanal kapatılır.
+func (d *Doctor) RunUpgrade(ctx context.Context, u *Upgrade, events chan<- PipelineEvent) (err error) {
+	defer close(events)
+
+	// Yedek: nil içerik dosyanın önceden olmadığı anlamına gelir
+	backup := make(map[string][]byte)
+	for _, plan := range u.Plans {
+		for _, f := range plan.Files {
+			if _, ok := backup[f]; ok {
+				continue
+			}
+			data, err := os.ReadFile(f)
+			if err != nil && !os.IsNotExist(err) {
+				events <- PipelineEvent{Unit: 0, Status: PipelineFailed, ExitCode: -1, Err: err}
+				skipUnits(events, 1, len(u.Units))
+				return err
+			}
+			backup[f] = data
+		}
+	}
+
+	var failed error
+	for _, plan := range u.Plans {
+		if err := os.WriteFile(plan.Manifest, plan.updated, 0644); err != nil {
+			failed = fmt.Errorf("%s yazılamadı: %v", plan.Manifest, err)
+			events <- PipelineEvent{Unit: 0, Status: PipelineFailed, ExitCode: -1, Err: failed}
+			break
+		}
+	}
+
+	start := 0
+	if failed != nil {
+		start = 1 // Yazma hatası ilk adımda gösterildi
+	}
+	for i := start; i < u.Rollback; i++ {
+		if failed != nil || ctx.Err() != nil {
+			events <- PipelineEvent{Unit: i, Status: PipelineSkipped}
+			continue
+		}
+		if _, err := runPipelineUnit(ctx, u.project, i, u.Units[i], events); err != nil {
+			failed = err
+		}
+	}
+	if failed == nil && ctx.Err() != nil {
+		failed = ctx.Err()
+	}
+	if failed == nil {
+		skipUnits(events, u.Rollback, len(u.Units))
+		return nil
+	}
+
+	// Geri alma iptal edilmez: yarım kalan kurulum projeyi bozuk bırakır
+	for f, data := range backup {
+		var err error
+		if data == nil {
+			err = os.Remove(f)
+		} else {
+			err = os.WriteFile(f, data, 0644)
+		}
+		if err != nil && !os.IsNotExist(err) {
+			err = fmt.Errorf("%s geri yüklenemedi: %v", f, err)
+			events <- PipelineEvent{Unit: u.Rollback, Status: PipelineFailed, ExitCode: -1, Err: err}
+			skipUnits(events, u.Rollback+1, len(u.Units))
+			return err
+		}
+	}
+	for i := u.Rollback; i < len(u.Units); i++ {
+		_, _ = runPipelineUnit(context.Background(), u.project, i, u.Units[i], events)
+	}
+	return failed
+}
+
+// skipUnits [from, to) aralığındaki birimleri atlandı olarak bildirir
+func skipUnits(events chan<- PipelineEvent, from, to int) {
+	for i := from; i < to; i++ {
+		events <- PipelineEvent{Unit: i, Status: PipelineSkipped}
+	}
+}
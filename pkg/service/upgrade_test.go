@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestBumpSpec(t *testing.T) {
+	tests := []struct {
+		spec, to string
+		want     string
+		ok       bool
+	}{
+		{"^1.2.0", "1.4.2", "^1.4.2", true},
+		{"~1.2.0", "1.2.9", "~1.2.9", true},
+		{">=1.0.0", "2.0.0", ">=2.0.0", true},
+		{"1.2.3", "1.2.4", "1.2.4", true},
+		{" ^1.x ", "1.5.0", "^1.5.0", true},
+		{"^2.0.0-beta.1", "2.0.0", "^2.0.0", true},
+		{"npm:string-width@^4.2.0", "4.2.3", "npm:string-width@^4.2.3", true},
+		{"npm:@scope/pkg@~1.0.0", "1.1.0", "npm:@scope/pkg@~1.1.0", true},
+
+		// Değiştirilmeyen tanımlar
+		{">=1.0.0 <2", "1.5.0", "", false},
+		{"^1.0.0 || ^2.0.0", "2.1.0", "", false},
+		{"latest", "2.0.0", "", false},
+		{"github:user/repo", "1.0.0", "", false},
+		{"file:../lib", "1.0.0", "", false},
+		{"workspace:^1.0.0", "1.1.0", "", false},
+		{"npm:string-width", "4.2.3", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := bumpSpec(tt.spec, tt.to)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("bumpSpec(%q, %q) = (%q, %v), beklenen (%q, %v)", tt.spec, tt.to, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestReplacePackageSpec(t *testing.T) {
+	data := []byte(`{
+  "name": "app",
+  "dependencies": {
+    "react": "^18.2.0",
+    "react-dom":"^18.2.0"
+  },
+  "devDependencies": {
+    "react": "^17.0.0",
+    "vite": "^5.0.0"
+  }
+}
+`)
+	bump := func(to string) func(string) (string, bool) {
+		return func(old string) (string, bool) { return bumpSpec(old, to) }
+	}
+
+	out, old, ok := replacePackageSpec(data, "dependencies", "react-dom", bump("18.3.1"))
+	if !ok || old != "^18.2.0" {
+		t.Fatalf("replacePackageSpec(react-dom) = (%q, %v)", old, ok)
+	}
+	if diff := lineDiff(data, out); len(diff) != 2 || diff[0] != `- "react-dom":"^18.2.0"` || diff[1] != `+ "react-dom":"^18.3.1"` {
+		t.Errorf("beklenmeyen fark: %q", diff)
+	}
+
+	// Aynı paket başka bölümde de olsa sadece istenen bölüm değişir
+	out, old, ok = replacePackageSpec(data, "devDependencies", "react", bump("17.0.2"))
+	if !ok || old != "^17.0.0" {
+		t.Fatalf("replacePackageSpec(devDependencies react) = (%q, %v)", old, ok)
+	}
+	if diff := lineDiff(data, out); len(diff) != 2 || diff[0] != `- "react": "^17.0.0",` || diff[1] != `+ "react": "^17.0.2",` {
+		t.Errorf("beklenmeyen fark: %q", diff)
+	}
+
+	// Bölümde olmayan paket ve değiştirilemeyen tanım
+	if out, old, ok := replacePackageSpec(data, "dependencies", "vite", bump("5.1.0")); ok || old != "" || string(out) != string(data) {
+		t.Errorf("dependencies'te olmayan vite değişti: (%q, %v)", old, ok)
+	}
+	if _, old, ok := replacePackageSpec(data, "peerDependencies", "react", bump("19.0.0")); ok || old != "" {
+		t.Errorf("olmayan bölüm: (%q, %v)", old, ok)
+	}
+	if out, old, ok := replacePackageSpec(data, "dependencies", "react", func(string) (string, bool) { return "", false }); ok || old != "^18.2.0" || string(out) != string(data) {
+		t.Errorf("reddedilen düzenleme veriyi değiştirdi: (%q, %v)", old, ok)
+	}
+}
+
+func TestRunUpgradeRollback(t *testing.T) {
+	skipWithoutShell(t)
+	dir := t.TempDir()
+	manifest := filepath.Join(dir, "package.json")
+	lockfile := filepath.Join(dir, "package-lock.json")
+	before := []byte(`{"dependencies": {"react": "^18.2.0"}}`)
+	if err := os.WriteFile(manifest, before, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// Kurulum kilit dosyasını oluşturup başarısız olur; geri alma adımı bir işaret bırakır
+	u := &Upgrade{
+		Plans: []UpgradePlan{{
+			Dir: dir, Manifest: manifest, Files: []string{manifest, lockfile},
+			updated: []byte(`{"dependencies": {"react": "^18.3.1"}}`),
+		}},
+		Units: []PipelineUnit{
+			{Name: "install", Dir: dir, Command: "echo {} > package-lock.json; exit 1"},
+			{Name: "verify", Dir: dir, Command: "true"},
+			{Name: "reinstall", Dir: dir, Command: "cat package.json > restored"},
+		},
+		Rollback: 2,
+		project:  dir,
+	}
+	events := make(chan PipelineEvent)
+	statuses := make(map[int]PipelineStatus)
+	done := make(chan struct{})
+	go func() {
+		for ev := range events {
+			statuses[ev.Unit] = ev.Status
+		}
+		close(done)
+	}()
+
+	if err := (&Doctor{}).RunUpgrade(context.Background(), u, events); err == nil {
+		t.Fatal("başarısız kurulumda hata bekleniyordu")
+	}
+	<-done
+
+	if got, _ := os.ReadFile(manifest); string(got) != string(before) {
+		t.Errorf("package.json geri yüklenmedi: %s", got)
+	}
+	if _, err := os.Stat(lockfile); !os.IsNotExist(err) {
+		t.Errorf("önceden olmayan kilit dosyası silinmedi: %v", err)
+	}
+	if got, _ := os.ReadFile(filepath.Join(dir, "restored")); string(got) != string(before) {
+		t.Errorf("geri alma kurulumu eski manifestle çalışmadı: %q", got)
+	}
+	want := map[int]PipelineStatus{0: PipelineFailed, 1: PipelineSkipped, 2: PipelinePassed}
+	for i, s := range want {
+		if statuses[i] != s {
+			t.Errorf("birim %d durumu %q, beklenen %q", i, statuses[i], s)
+		}
+	}
+}
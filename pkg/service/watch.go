@@ -0,0 +1,244 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"devterminal/pkg/domain"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// defaultWatchDebounce art arda gelen kayıt olaylarını tek değişiklikte toplamak için beklenen süre
+const defaultWatchDebounce = 300 * time.Millisecond
+
+// watchNoise editörlerin geçici dosyaları; hiçbir zaman değişiklik sayılmaz
+var watchNoise = []string{"*.swp", "*.swx", "*~", ".#*", "#*#", "*.tmp", "4913"}
+
+// Watcher bir klasör ağacını izler ve filtreden geçen değişiklikleri toplu bildirir
+type Watcher struct {
+	Root     string
+	Include  []string
+	Exclude  []string
+	Ignored  map[string]bool // Hiç girilmeyecek klasör/dosya adları (config IgnoredFiles)
+	Debounce time.Duration
+}
+
+// NewWatcher config'deki IgnoredFiles ve proje izleme ayarlarıyla bir Watcher oluşturur
+func NewWatcher(root string, opts domain.WatchOptions, ignored []string) *Watcher {
+	w := &Watcher{
+		Root:     root,
+		Include:  opts.Include,
+		Exclude:  opts.Exclude,
+		Ignored:  make(map[string]bool, len(ignored)),
+		Debounce: defaultWatchDebounce,
+	}
+	for _, name := range ignored {
+		w.Ignored[name] = true
+	}
+	if d, err := time.ParseDuration(opts.Debounce); err == nil && d > 0 {
+		w.Debounce = d
+	}
+	return w
+}
+
+// Describe izleme ayarlarını tek satırda özetler (loglar ve UI için)
+func (w *Watcher) Describe() string {
+	desc := "tüm dosyalar"
+	if len(w.Include) > 0 {
+		desc = strings.Join(w.Include, ", ")
+	}
+	if len(w.Exclude) > 0 {
+		desc += " (hariç: " + strings.Join(w.Exclude, ", ") + ")"
+	}
+	return desc
+}
+
+// Start klasör ağacını izlemeye başlar. Her debounce penceresinde değişen dosyalar
+// (köke göre, sıralı) kanala yazılır; ctx iptal edilince izleme durur ve kanal kapanır.
+func (w *Watcher) Start(ctx context.Context) (<-chan []string, error) {
+	fw, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("dosya izleyici başlatılamadı: %w", err)
+	}
+	if err := w.addTree(fw, w.Root); err != nil {
+		fw.Close()
+		return nil, err
+	}
+
+	changes := make(chan []string)
+	go w.loop(ctx, fw, changes)
+	return changes, nil
+}
+
+func (w *Watcher) loop(ctx context.Context, fw *fsnotify.Watcher, changes chan<- []string) {
+	defer close(changes)
+	defer fw.Close()
+
+	pending := make(map[string]bool)
+	timer := time.NewTimer(time.Hour)
+	timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case ev, ok := <-fw.Events:
+			if !ok {
+				return
+			}
+			if w.skipped(ev.Name) {
+				continue
+			}
+			// fsnotify alt klasörleri kendiliğinden izlemez; yeni klasörleri ekle
+			if ev.Has(fsnotify.Create) {
+				if info, err := os.Stat(ev.Name); err == nil && info.IsDir() {
+					_ = w.addTree(fw, ev.Name)
+					continue
+				}
+			}
+			if ev.Op == fsnotify.Chmod || !w.matches(ev.Name) {
+				continue
+			}
+			rel, err := filepath.Rel(w.Root, ev.Name)
+			if err != nil {
+				continue
+			}
+			pending[filepath.ToSlash(rel)] = true
+			timer.Reset(w.Debounce)
+
+		case <-timer.C:
+			files := make([]string, 0, len(pending))
+			for f := range pending {
+				files = append(files, f)
+			}
+			sort.Strings(files)
+			pending = make(map[string]bool)
+
+			select {
+			case changes <- files:
+			case <-ctx.Done():
+				return
+			}
+
+		case <-fw.Errors:
+			// İzleme hataları (örn: silinen klasör) izlemeyi durdurmaz
+		}
+	}
+}
+
+// addTree klasörü ve alt klasörlerini izlemeye ekler (yok sayılanlar hariç)
+func (w *Watcher) addTree(fw *fsnotify.Watcher, dir string) error {
+	return filepath.WalkDir(dir, func(path string, d os.DirEntry, err error) error {
+		if err != nil || !d.IsDir() {
+			return nil
+		}
+		if path != w.Root && w.skipped(path) {
+			return filepath.SkipDir
+		}
+		if err := fw.Add(path); err != nil {
+			return fmt.Errorf("%s izlenemiyor: %w", path, err)
+		}
+		return nil
+	})
+}
+
+// skipped yol IgnoredFiles, gizli klasörler veya exclude glob'larına takılıyor mu
+func (w *Watcher) skipped(path string) bool {
+	rel, err := filepath.Rel(w.Root, path)
+	if err != nil || rel == "." {
+		return false
+	}
+	for _, part := range strings.Split(filepath.ToSlash(rel), "/") {
+		if w.Ignored[part] || (strings.HasPrefix(part, ".") && part != ".") {
+			return true
+		}
+	}
+	return w.matchGlobs(path, w.Exclude) || w.matchGlobs(path, watchNoise)
+}
+
+// matches dosya include glob'larından birine uyuyor mu (include yoksa her dosya uyar)
+func (w *Watcher) matches(path string) bool {
+	return len(w.Include) == 0 || w.matchGlobs(path, w.Include)
+}
+
+// matchGlobs "/" içermeyen desenleri dosya adına, diğerlerini köke göre yola uygular
+func (w *Watcher) matchGlobs(path string, patterns []string) bool {
+	base := filepath.Base(path)
+	for _, pat := range patterns {
+		if !strings.Contains(pat, "/") {
+			if ok, _ := filepath.Match(pat, base); ok {
+				return true
+			}
+			continue
+		}
+		if matchesAny(w.Root, path, []string{pat}) {
+			return true
+		}
+	}
+	return false
+}
+
+// watchDefaults include tanımlı değilse klasördeki teknolojiye göre izlenecek kaynak dosyalar.
+// Uygulamanın kendi yazdığı dosyalar (sqlite, log, upload) yeniden başlatma döngüsüne sokmasın diye
+// mümkün olduğunda sadece kaynak kod izlenir.
+var watchDefaults = []struct {
+	marker  string
+	include []string
+}{
+	{"go.mod", []string{"*.go", "go.mod", "go.sum", "*.tmpl", "*.html"}},
+	{"manage.py", []string{"*.py", "*.html"}},
+	{"pyproject.toml", []string{"*.py", "pyproject.toml"}},
+	{"requirements.txt", []string{"*.py", "requirements.txt"}},
+	{"composer.json", []string{"*.php", "composer.json"}},
+	{"pom.xml", []string{"*.java", "*.kt", "*.properties", "*.yml", "pom.xml"}},
+	{"build.gradle", []string{"*.java", "*.kt", "*.properties", "*.yml", "*.gradle"}},
+	{"Cargo.toml", []string{"*.rs", "Cargo.toml"}},
+	// JSON'dan sadece manifest ve tsconfig: önbellek / veri dosyaları döngüye sokmasın
+	{"package.json", []string{"*.js", "*.jsx", "*.mjs", "*.cjs", "*.ts", "*.tsx", "*.mts", "*.cts", "package.json", "tsconfig*.json"}},
+}
+
+// defaultWatchInclude klasördeki işaret dosyasına göre varsayılan include glob'larını döndürür
+func defaultWatchInclude(dir string) []string {
+	for _, d := range watchDefaults {
+		if _, err := os.Stat(filepath.Join(dir, d.marker)); err == nil {
+			return d.include
+		}
+	}
+	return nil
+}
+
+// NewProjectWatcher proje ayarlarındaki izleme seçenekleriyle dir klasörü için Watcher oluşturur
+func (l *Launcher) NewProjectWatcher(projectPath, dir string) *Watcher {
+	opts := l.Config.ProjectOverrides[strings.ToLower(projectPath)].Watch
+	if len(opts.Include) == 0 {
+		opts.Include = defaultWatchInclude(dir)
+	}
+	return NewWatcher(dir, opts, l.Config.IgnoredFiles)
+}
+
+// WatchProcess süreci çalışma dizinindeki değişikliklerde yeniden başlatır.
+// Hot reload'u olmayan backend'ler (örn: "go run .") için kullanılır.
+func (l *Launcher) WatchProcess(id int) error {
+	mp := l.Supervisor.Get(id)
+	if mp == nil {
+		return fmt.Errorf("süreç bulunamadı: %d", id)
+	}
+	w := l.NewProjectWatcher(mp.Spec.ProjectPath, mp.Spec.Dir)
+	return l.Supervisor.Watch(id, w)
+}
+
+// summarizeChanges değişen dosyaları log satırı için kısaltır ("main.go, api/x.go +3")
+func summarizeChanges(files []string) string {
+	const shown = 2
+	if len(files) <= shown {
+		return strings.Join(files, ", ")
+	}
+	return fmt.Sprintf("%s +%d", strings.Join(files[:shown], ", "), len(files)-shown)
+}
@@ -0,0 +1,36 @@
+package service
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"devterminal/pkg/domain"
+)
+
+func TestWatchDefaultsPackageJSON(t *testing.T) {
+	root := t.TempDir()
+	if err := os.WriteFile(filepath.Join(root, "package.json"), []byte("{}"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	w := NewWatcher(root, domain.WatchOptions{Include: defaultWatchInclude(root)}, nil)
+
+	tests := map[string]bool{
+		"src/App.tsx":          true,
+		"src/Button.jsx":       true,
+		"src/server.ts":        true,
+		"lib/util.mjs":         true,
+		"package.json":         true,
+		"tsconfig.json":        true,
+		"tsconfig.build.json":  true,
+		"data/cache.json":      false,
+		"public/manifest.json": false,
+		"db.sqlite":            false,
+		"logs/app.log":         false,
+	}
+	for rel, want := range tests {
+		if got := w.matches(filepath.Join(root, rel)); got != want {
+			t.Errorf("matches(%q) = %v, beklenen %v", rel, got, want)
+		}
+	}
+}
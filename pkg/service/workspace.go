@@ -0,0 +1,275 @@
+package service
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// maxWorkspaceDepth "**" desenleri ve project.json araması için inilecek en fazla klasör derinliği
+const maxWorkspaceDepth = 4
+
+// workspaceSkipDirs workspace aramasında hiç girilmeyecek klasörler
+var workspaceSkipDirs = map[string]bool{
+	"node_modules": true, ".git": true, "dist": true, "build": true,
+	".next": true, ".turbo": true, ".nx": true, "coverage": true, "vendor": true,
+}
+
+// workspaceFile package.json'daki workspace ile ilgili alanlar
+type workspaceFile struct {
+	Name       string          `json:"name"`
+	Workspaces json.RawMessage `json:"workspaces"` // ["apps/*"] veya {"packages": ["apps/*"]}
+}
+
+// hasWorkspaceConfig projede açık bir workspace tanımı olup olmadığını kontrol eder
+func hasWorkspaceConfig(projectPath string) bool {
+	for _, name := range []string{"pnpm-workspace.yaml", "lerna.json", "turbo.json", "nx.json"} {
+		if _, err := os.Stat(filepath.Join(projectPath, name)); err == nil {
+			return true
+		}
+	}
+	return len(packageJSONWorkspaces(projectPath)) > 0
+}
+
+// workspaceDirs workspace dosyalarındaki glob'ları genişletip alt proje klasörlerini döndürür.
+// Workspace tanımı yoksa nil döner (klasör adı tahminine geri dönülür).
+func workspaceDirs(projectPath string) []string {
+	var include, exclude []string
+	addPatterns := func(patterns []string) {
+		for _, pat := range patterns {
+			pat = strings.TrimSpace(pat)
+			if strings.HasPrefix(pat, "!") {
+				exclude = append(exclude, strings.TrimPrefix(pat, "!"))
+			} else if pat != "" {
+				include = append(include, pat)
+			}
+		}
+	}
+
+	// 1. pnpm-workspace.yaml
+	if data, err := os.ReadFile(filepath.Join(projectPath, "pnpm-workspace.yaml")); err == nil {
+		var ws struct {
+			Packages []string `yaml:"packages"`
+		}
+		if yaml.Unmarshal(data, &ws) == nil {
+			addPatterns(ws.Packages)
+		}
+	}
+
+	// 2. package.json workspaces (npm / yarn / bun)
+	addPatterns(packageJSONWorkspaces(projectPath))
+
+	// 3. lerna.json
+	if data, err := os.ReadFile(filepath.Join(projectPath, "lerna.json")); err == nil {
+		var lerna struct {
+			Packages []string `json:"packages"`
+		}
+		if json.Unmarshal(data, &lerna) == nil {
+			if len(lerna.Packages) == 0 {
+				lerna.Packages = []string{"packages/*"} // Lerna varsayılanı
+			}
+			addPatterns(lerna.Packages)
+		}
+	}
+
+	// 4. nx.json (workspaceLayout) ve project.json dosyaları
+	nxDirs := nxProjectDirs(projectPath)
+
+	// 5. turbo.json kendi glob'unu tutmaz, paket yöneticisinin workspace'ini kullanır
+	if len(include) == 0 && len(nxDirs) == 0 {
+		if _, err := os.Stat(filepath.Join(projectPath, "turbo.json")); err == nil {
+			include = []string{"apps/*", "packages/*"}
+		}
+	}
+
+	seen := make(map[string]bool)
+	var dirs []string
+	add := func(dir string) {
+		if seen[dir] || dir == projectPath || matchesAny(projectPath, dir, exclude) {
+			return
+		}
+		seen[dir] = true
+		dirs = append(dirs, dir)
+	}
+	for _, pat := range include {
+		for _, dir := range expandWorkspaceGlob(projectPath, pat) {
+			add(dir)
+		}
+	}
+	for _, dir := range nxDirs {
+		add(dir)
+	}
+	sort.Strings(dirs)
+	return dirs
+}
+
+// packageJSONWorkspaces package.json'daki workspaces alanını iki formatta da okur
+func packageJSONWorkspaces(projectPath string) []string {
+	data, err := os.ReadFile(filepath.Join(projectPath, "package.json"))
+	if err != nil {
+		return nil
+	}
+	var pkg workspaceFile
+	if json.Unmarshal(data, &pkg) != nil || len(pkg.Workspaces) == 0 {
+		return nil
+	}
+
+	var list []string
+	if json.Unmarshal(pkg.Workspaces, &list) == nil {
+		return list
+	}
+	var obj struct {
+		Packages []string `json:"packages"`
+	}
+	if json.Unmarshal(pkg.Workspaces, &obj) == nil {
+		return obj.Packages
+	}
+	return nil
+}
+
+// nxProjectDirs Nx workspace'indeki project.json içeren klasörleri bulur
+func nxProjectDirs(projectPath string) []string {
+	data, err := os.ReadFile(filepath.Join(projectPath, "nx.json"))
+	if err != nil {
+		return nil
+	}
+	var nx struct {
+		WorkspaceLayout struct {
+			AppsDir string `json:"appsDir"`
+			LibsDir string `json:"libsDir"`
+		} `json:"workspaceLayout"`
+	}
+	_ = json.Unmarshal(data, &nx)
+
+	roots := []string{nx.WorkspaceLayout.AppsDir, nx.WorkspaceLayout.LibsDir}
+	if roots[0] == "" {
+		roots[0] = "apps"
+	}
+	if roots[1] == "" {
+		roots[1] = "libs"
+	}
+
+	var dirs []string
+	for _, root := range roots {
+		walkWorkspace(filepath.Join(projectPath, root), 0, func(dir string) {
+			if _, err := os.Stat(filepath.Join(dir, "project.json")); err == nil {
+				dirs = append(dirs, dir)
+			}
+		})
+	}
+	return dirs
+}
+
+// expandWorkspaceGlob "apps/*", "packages/**" veya "tools/cli" gibi desenleri klasörlere çevirir
+func expandWorkspaceGlob(projectPath, pattern string) []string {
+	pattern = strings.TrimSuffix(filepath.ToSlash(pattern), "/")
+	pattern = strings.TrimPrefix(pattern, "./")
+
+	if !strings.Contains(pattern, "**") {
+		matches, _ := filepath.Glob(filepath.Join(projectPath, filepath.FromSlash(pattern)))
+		var dirs []string
+		for _, match := range matches {
+			if info, err := os.Stat(match); err == nil && info.IsDir() && !workspaceSkipDirs[info.Name()] {
+				dirs = append(dirs, match)
+			}
+		}
+		return dirs
+	}
+
+	// "**" için: desenin sabit önekinden başlayıp klasörleri gez ve eşleşenleri al
+	prefix := strings.SplitN(pattern, "**", 2)[0]
+	base := filepath.Join(projectPath, filepath.FromSlash(strings.TrimSuffix(prefix, "/")))
+	var dirs []string
+	walkWorkspace(base, 0, func(dir string) {
+		if matchesAny(projectPath, dir, []string{pattern}) && isPackageDir(dir) {
+			dirs = append(dirs, dir)
+		}
+	})
+	return dirs
+}
+
+// walkWorkspace klasörleri maxWorkspaceDepth derinliğe kadar gezer
+func walkWorkspace(dir string, depth int, visit func(dir string)) {
+	if depth > maxWorkspaceDepth {
+		return
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return
+	}
+	for _, e := range entries {
+		if !e.IsDir() || workspaceSkipDirs[e.Name()] || strings.HasPrefix(e.Name(), ".") {
+			continue
+		}
+		sub := filepath.Join(dir, e.Name())
+		visit(sub)
+		walkWorkspace(sub, depth+1, visit)
+	}
+}
+
+// matchesAny klasörün (proje köküne göre) desenlerden birine uyup uymadığını kontrol eder
+func matchesAny(projectPath, dir string, patterns []string) bool {
+	rel, err := filepath.Rel(projectPath, dir)
+	if err != nil {
+		return false
+	}
+	rel = filepath.ToSlash(rel)
+	for _, pat := range patterns {
+		pat = strings.TrimPrefix(strings.TrimSuffix(filepath.ToSlash(pat), "/"), "./")
+		if globMatch(strings.Split(pat, "/"), strings.Split(rel, "/")) {
+			return true
+		}
+	}
+	return false
+}
+
+// globMatch yol parçalarını "**" (sıfır veya daha fazla klasör) destekleyerek eşleştirir
+func globMatch(pattern, parts []string) bool {
+	if len(pattern) == 0 {
+		return len(parts) == 0
+	}
+	if pattern[0] == "**" {
+		for i := 0; i <= len(parts); i++ {
+			if globMatch(pattern[1:], parts[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(parts) == 0 {
+		return false
+	}
+	if ok, _ := filepath.Match(pattern[0], parts[0]); !ok {
+		return false
+	}
+	return globMatch(pattern[1:], parts[1:])
+}
+
+// isPackageDir klasörün kendi başına bir paket/proje olup olmadığını kontrol eder
+func isPackageDir(dir string) bool {
+	for _, name := range []string{"package.json", "project.json", "go.mod", "pyproject.toml", "composer.json", "Cargo.toml"} {
+		if _, err := os.Stat(filepath.Join(dir, name)); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// workspacePackageName alt projenin paket adını (package.json veya project.json "name") döndürür
+func workspacePackageName(dir string) string {
+	for _, name := range []string{"package.json", "project.json"} {
+		data, err := os.ReadFile(filepath.Join(dir, name))
+		if err != nil {
+			continue
+		}
+		var pkg workspaceFile
+		if json.Unmarshal(data, &pkg) == nil && pkg.Name != "" {
+			return pkg.Name
+		}
+	}
+	return ""
+}
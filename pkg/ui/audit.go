@@ -0,0 +1,253 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"devterminal/pkg/domain"
+	"devterminal/pkg/service"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// auditMsg güvenlik taraması bittiğinde gönderilir
+type auditMsg struct {
+	report *service.AuditReport
+	err    error
+}
+
+// doctorKey sonuçların hangi proje ve moda ait olduğunu belirtir (sekmeler arası geçişte yeniden taramamak için)
+func (m *MainModel) doctorKey() string {
+	return fmt.Sprintf("%s|%v", m.Selected.Path, m.DoctorOffline)
+}
+
+// openAudit güvenlik sekmesini açar ve taramayı başlatır
+func (m *MainModel) openAudit() tea.Cmd {
+	m.State = StateSecurityAudit
+	m.AuditReport = nil
+	m.AuditErr = nil
+	m.AuditLoading = true
+	m.auditFor = m.doctorKey()
+	m.AuditTable.SetRows([]table.Row{})
+	return tea.Batch(m.Spinner.Tick, m.auditCmd())
+}
+
+// switchDoctorTab doktor, güvenlik ve import sekmeleri arasında sırayla geçer; sonucu güncel olan sekme yeniden taranmaz
+func (m *MainModel) switchDoctorTab() tea.Cmd {
+	switch m.State {
+	case StateSecurityAudit:
+		if m.importsFor == m.Selected.Path && !m.ImportLoading {
+			m.State = StateImportCheck
+			return nil
+		}
+		return m.openImports()
+	case StateImportCheck:
+		if m.doctorFor == m.doctorKey() && !m.DoctorLoading {
+			m.State = StateDependencyDoctor
+			return nil
+		}
+		return m.openDoctor()
+	}
+	if m.auditFor == m.doctorKey() && !m.AuditLoading {
+		m.State = StateSecurityAudit
+		return nil
+	}
+	return m.openAudit()
+}
+
+func (m *MainModel) auditCmd() tea.Cmd {
+	p := m.Selected
+	offline := m.DoctorOffline
+	return func() tea.Msg {
+		report, err := m.Doctor.Audit(p, offline)
+		return auditMsg{report: report, err: err}
+	}
+}
+
+// applyAuditReport sonuçları tabloya yükler
+func (m *MainModel) applyAuditReport(msg auditMsg) {
+	m.AuditLoading = false
+	m.AuditReport = msg.report
+	m.AuditErr = msg.err
+	m.refreshAuditRows()
+}
+
+// refreshAuditRows seçili sıralamaya göre tabloyu yeniden doldurur
+func (m *MainModel) refreshAuditRows() {
+	if m.AuditReport == nil {
+		m.AuditTable.SetRows([]table.Row{})
+		return
+	}
+	service.SortVulns(m.AuditReport.Vulns, m.AuditByName)
+	rows := make([]table.Row, 0, len(m.AuditReport.Vulns))
+	for _, v := range m.AuditReport.Vulns {
+		rows = append(rows, table.Row{
+			severityLabel(v.Severity), v.Name, orDash(v.Version), v.ID, orDash(v.Range), orDash(v.Fixed),
+		})
+	}
+	m.AuditTable.SetRows(rows)
+	m.AuditTable.SetCursor(0)
+	m.resizeAuditTable()
+}
+
+// resizeAuditTable tabloyu ekran yüksekliğine sığdırır (altta seçili advisory'nin detayı için yer bırakır)
+func (m *MainModel) resizeAuditTable() {
+	h := m.Height - 15
+	if n := len(m.AuditTable.Rows()) + 1; n < h {
+		h = n
+	}
+	if h < 3 {
+		h = 3
+	}
+	m.AuditTable.SetHeight(h)
+}
+
+func (m *MainModel) updateAudit(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.State = StateProjectActions
+		return m, nil
+	case "q":
+		return m, tea.Quit
+	case "tab":
+		return m, m.switchDoctorTab()
+	case "r":
+		if !m.AuditLoading {
+			return m, m.openAudit()
+		}
+		return m, nil
+	case "o":
+		if !m.AuditLoading {
+			m.DoctorOffline = !m.DoctorOffline
+			return m, m.openAudit()
+		}
+		return m, nil
+	case "s":
+		// Önem derecesi <-> paket adı sıralaması
+		m.AuditByName = !m.AuditByName
+		m.refreshAuditRows()
+		return m, nil
+	}
+	var cmd tea.Cmd
+	m.AuditTable, cmd = m.AuditTable.Update(msg)
+	return m, cmd
+}
+
+func newAuditTable() table.Model {
+	t := newTable()
+	t.SetColumns([]table.Column{
+		{Title: "Önem", Width: 12},
+		{Title: "Paket", Width: 24},
+		{Title: "Sürüm", Width: 10},
+		{Title: "Advisory", Width: 20},
+		{Title: "Etkilenen", Width: 18},
+		{Title: "Düzeltme", Width: 10},
+	})
+	return t
+}
+
+// severityLabel önem derecesinin tablodaki karşılığıdır
+func severityLabel(s domain.Severity) string {
+	switch s {
+	case domain.SeverityCritical:
+		return "🟥 kritik"
+	case domain.SeverityHigh:
+		return "🟧 yüksek"
+	case domain.SeverityModerate:
+		return "🟨 orta"
+	case domain.SeverityLow:
+		return "🟩 düşük"
+	}
+	return "⬜ bilinmiyor"
+}
+
+// doctorTabs doktor, güvenlik ve import ekranlarının üstündeki sekme çubuğudur
+func (m *MainModel) doctorTabs() string {
+	active := lipgloss.NewStyle().Bold(true).Foreground(ColorGreen).Underline(true)
+	inactive := lipgloss.NewStyle().Foreground(ColorGrey)
+	var tabs []string
+	for _, tab := range []struct {
+		state SessionState
+		title string
+	}{{StateDependencyDoctor, "🩺 Doktor"}, {StateSecurityAudit, "🛡️ Güvenlik"}, {StateImportCheck, "🔎 Importlar"}} {
+		if m.State == tab.state {
+			tabs = append(tabs, active.Render(tab.title))
+		} else {
+			tabs = append(tabs, inactive.Render(tab.title))
+		}
+	}
+	return strings.Join(tabs, inactive.Render("  │  "))
+}
+
+func (m *MainModel) auditView() string {
+	var b strings.Builder
+	greyStyle := lipgloss.NewStyle().Foreground(ColorGrey)
+
+	b.WriteString("\n" + m.doctorTabs() + "\n")
+	title := "🛡️ " + m.Selected.Name + " İçin Güvenlik Taraması"
+	if m.DoctorOffline {
+		title += " (offline)"
+	}
+	if r := m.AuditReport; r != nil && len(r.Sources) > 0 {
+		title += " (" + strings.Join(r.Sources, ", ") + ")"
+	}
+	b.WriteString("\n" + HeaderStyle.Render(title) + "\n")
+
+	switch {
+	case m.AuditLoading:
+		b.WriteString("\n" + m.Spinner.View() + " Güvenlik açıkları taranıyor...\n")
+	case m.AuditErr != nil:
+		b.WriteString("\n" + lipgloss.NewStyle().Foreground(ColorRed).Render("⚠️  Hata: "+m.AuditErr.Error()) + "\n")
+		b.WriteString(greyStyle.Render("OSV dökümü için: https://osv-vulnerabilities.storage.googleapis.com/npm/all.zip (Go için /Go/all.zip) dosyalarını ~/.devterminal/osv klasörüne koyun") + "\n")
+	case len(m.AuditTable.Rows()) == 0:
+		b.WriteString("\n" + lipgloss.NewStyle().Foreground(ColorGreen).Render("✅ Bilinen güvenlik açığı bulunamadı!") + "\n")
+	default:
+		// Önem derecelerine göre özet (örn: 2 kritik, 5 yüksek)
+		counts := make(map[domain.Severity]int)
+		for _, v := range m.AuditReport.Vulns {
+			counts[v.Severity]++
+		}
+		var parts []string
+		for _, s := range []domain.Severity{domain.SeverityCritical, domain.SeverityHigh, domain.SeverityModerate, domain.SeverityLow, domain.SeverityUnknown} {
+			if counts[s] > 0 {
+				parts = append(parts, fmt.Sprintf("%d %s", counts[s], strings.Fields(severityLabel(s))[1]))
+			}
+		}
+		order := "önem derecesi"
+		if m.AuditByName {
+			order = "paket adı"
+		}
+		b.WriteString(greyStyle.Render(fmt.Sprintf("%d bulgu: %s · Sıralama: %s", len(m.AuditReport.Vulns), strings.Join(parts, ", "), order)) + "\n")
+		b.WriteString(m.AuditTable.View() + "\n")
+
+		// Seçili advisory'nin başlığı ve bağlantısı
+		if i := m.AuditTable.Cursor(); i >= 0 && i < len(m.AuditReport.Vulns) {
+			v := m.AuditReport.Vulns[i]
+			b.WriteString(lipgloss.NewStyle().Bold(true).Render(v.Title) + "\n")
+			detail := v.URL
+			if len(v.Aliases) > 0 {
+				detail += " · " + strings.Join(v.Aliases, ", ")
+			}
+			b.WriteString(greyStyle.Render(detail+" · "+v.Source) + "\n")
+		}
+	}
+
+	if r := m.AuditReport; r != nil {
+		if r.OSV != "" {
+			b.WriteString(greyStyle.Render("OSV: "+r.OSV) + "\n")
+		}
+		for _, e := range r.Errors {
+			b.WriteString(lipgloss.NewStyle().Foreground(ColorYellow).Render("⚠️  "+e) + "\n")
+		}
+	}
+
+	content := lipgloss.NewStyle().PaddingLeft(2).Render(b.String())
+	mode := "Offline"
+	if m.DoctorOffline {
+		mode = "Online"
+	}
+	pairs := []string{"↑↓", "Gezin", "s", "Sırala", "r", "Yeniden Tara", "o", mode, "Tab", "Importlar", "Esc", "Geri Dön"}
+	return content + "\n  " + m.renderFooter(pairs...)
+}
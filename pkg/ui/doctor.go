@@ -0,0 +1,370 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"devterminal/pkg/domain"
+	"devterminal/pkg/service"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// doctorMsg tüm ekosistemlerin kontrolü bittiğinde gönderilir
+type doctorMsg struct {
+	report *service.DoctorReport
+	err    error
+}
+
+// openDoctor Dependency Doctor ekranını açar ve kontrolü başlatır
+func (m *MainModel) openDoctor() tea.Cmd {
+	m.State = StateDependencyDoctor
+	m.DoctorReport = nil
+	m.DoctorErr = nil
+	m.DoctorLoading = true
+	m.DoctorSelected = make(map[string]bool)
+	m.UpgradeErr = nil
+	m.doctorFor = m.doctorKey()
+	m.Table.SetRows([]table.Row{}) // Clear old results
+	// Satırlar temizlendikten sonra: kolon sayısı moda göre değişir
+	m.Table.SetColumns(doctorColumns(m.DoctorOffline))
+	return tea.Batch(m.Spinner.Tick, m.checkDependenciesCmd())
+}
+
+// doctorColumns online (outdated) ve offline (kilit dosyası) tablolarının kolonlarıdır
+func doctorColumns(offline bool) []table.Column {
+	if offline {
+		return []table.Column{
+			{Title: "Ekosistem", Width: 12},
+			{Title: "Paket", Width: 28},
+			{Title: "Sorun", Width: 14},
+			{Title: "Manifest", Width: 12},
+			{Title: "Kilit", Width: 10},
+			{Title: "Kurulu", Width: 10},
+		}
+	}
+	return []table.Column{
+		{Title: "Ekosistem", Width: 12},
+		{Title: "Paket", Width: 28},
+		{Title: "Mevcut", Width: 10},
+		{Title: "İstenen", Width: 10},
+		{Title: "Son", Width: 10},
+		{Title: "Tür", Width: 14},
+	}
+}
+
+func (m *MainModel) checkDependenciesCmd() tea.Cmd {
+	p := m.Selected
+	offline := m.DoctorOffline
+	return func() tea.Msg {
+		check := m.Doctor.CheckDependencies
+		if offline {
+			check = m.Doctor.CheckLockfiles
+		}
+		report, err := check(p)
+		return doctorMsg{report: report, err: err}
+	}
+}
+
+// applyDoctorReport sonuçları tabloya yükler
+func (m *MainModel) applyDoctorReport(msg doctorMsg) {
+	m.DoctorLoading = false
+	m.DoctorReport = msg.report
+	m.DoctorErr = msg.err
+	m.refreshDoctorRows()
+	m.Table.SetCursor(0)
+	m.resizeDoctorTable()
+}
+
+// refreshDoctorRows raporu tabloya yazar; yükseltme için seçilen paketler işaretlenir
+func (m *MainModel) refreshDoctorRows() {
+	r := m.DoctorReport
+	if r == nil {
+		m.Table.SetRows([]table.Row{})
+		return
+	}
+
+	var rows []table.Row
+	if r.Offline {
+		for _, d := range r.Drift {
+			rows = append(rows, table.Row{
+				ecosystemIcon(d.Ecosystem) + " " + d.Ecosystem, d.Name, driftLabel(d.Issue),
+				orDash(d.Declared), orDash(d.Locked), orDash(d.Installed),
+			})
+		}
+	} else {
+		for _, d := range m.visibleDeps() {
+			name := "  " + d.Name
+			if m.DoctorSelected[depKey(d)] {
+				name = "✔ " + d.Name
+			}
+			rows = append(rows, table.Row{ecosystemIcon(d.Ecosystem) + " " + d.Ecosystem, name, d.Current, d.Wanted, d.Latest, updateLabel(d)})
+		}
+	}
+	m.Table.SetRows(rows)
+}
+
+// visibleDeps filtrelere (sadece major / dev / doğrudan) uyan paketlerdir; tablo satırlarıyla aynı sıradadır
+func (m *MainModel) visibleDeps() []domain.OutdatedDependency {
+	if m.DoctorReport == nil {
+		return nil
+	}
+	var deps []domain.OutdatedDependency
+	for _, d := range m.DoctorReport.Deps {
+		if (m.DoctorMajorOnly && d.Update != domain.UpdateMajor) ||
+			(m.DoctorDevOnly && !d.Dev) ||
+			(m.DoctorDirectOnly && !d.Direct) {
+			continue
+		}
+		deps = append(deps, d)
+	}
+	return deps
+}
+
+// doctorFilters açık filtrelerin adları
+func (m *MainModel) doctorFilters() []string {
+	var f []string
+	if m.DoctorMajorOnly {
+		f = append(f, "major")
+	}
+	if m.DoctorDevOnly {
+		f = append(f, "dev")
+	}
+	if m.DoctorDirectOnly {
+		f = append(f, "doğrudan")
+	}
+	return f
+}
+
+// toggleDoctorFilter filtreyi açıp kapatır ve tabloyu baştan gösterir
+func (m *MainModel) toggleDoctorFilter(filter *bool) {
+	*filter = !*filter
+	m.refreshDoctorRows()
+	m.Table.SetCursor(0)
+	m.resizeDoctorTable()
+}
+
+// resizeDoctorTable tabloyu ekran yüksekliğine sığdırır
+func (m *MainModel) resizeDoctorTable() {
+	h := m.Height - 14
+	if n := len(m.Table.Rows()) + 1; n < h {
+		h = n
+	}
+	if h < 3 {
+		h = 3
+	}
+	m.Table.SetHeight(h)
+}
+
+func (m *MainModel) updateDoctor(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.State = StateProjectActions
+		return m, nil
+	case "q":
+		return m, tea.Quit
+	case "tab":
+		return m, m.switchDoctorTab()
+	case "r":
+		if !m.DoctorLoading {
+			return m, m.openDoctor()
+		}
+		return m, nil
+	case "o":
+		// Online (outdated) <-> offline (kilit dosyası) modu
+		if !m.DoctorLoading {
+			m.DoctorOffline = !m.DoctorOffline
+			return m, m.openDoctor()
+		}
+		return m, nil
+	case " ", "x":
+		if m.canUpgrade() {
+			m.toggleDoctorSelection()
+		}
+		return m, nil
+	case "m", "d", "i":
+		// Filtreler birlikte uygulanır: sadece major / devDependencies / doğrudan bağımlılıklar
+		if m.canUpgrade() {
+			switch msg.String() {
+			case "m":
+				m.toggleDoctorFilter(&m.DoctorMajorOnly)
+			case "d":
+				m.toggleDoctorFilter(&m.DoctorDevOnly)
+			default:
+				m.toggleDoctorFilter(&m.DoctorDirectOnly)
+			}
+		}
+		return m, nil
+	case "a":
+		if m.canUpgrade() {
+			m.selectAllDeps()
+		}
+		return m, nil
+	case "w", "l":
+		// Seçili paketleri (seçim yoksa imleçtekini) Wanted / Latest sürüme yükselt
+		if m.canUpgrade() {
+			target := service.UpgradeWanted
+			if msg.String() == "l" {
+				target = service.UpgradeLatest
+			}
+			m.openUpgrade(target)
+		}
+		return m, nil
+	}
+	var cmd tea.Cmd
+	m.Table, cmd = m.Table.Update(msg)
+	return m, cmd
+}
+
+// ecosystemIcon doktor tablosunda ekosistem için ikon döndürür
+func ecosystemIcon(eco string) string {
+	icons := map[string]string{
+		"npm":      "📦",
+		"pnpm":     "📦",
+		"yarn":     "🧶",
+		"bun":      "🥟",
+		"go":       "🐹",
+		"pip":      "🐍",
+		"composer": "🎼",
+		"cargo":    "🦀",
+	}
+	if icon, ok := icons[eco]; ok {
+		return icon
+	}
+	return "📦"
+}
+
+// driftLabel kilit dosyası uyumsuzluğunun tablodaki karşılığıdır
+func driftLabel(issue domain.DriftIssue) string {
+	switch issue {
+	case domain.DriftUnsatisfied:
+		return "aralık dışı"
+	case domain.DriftMissing:
+		return "kilitte yok"
+	case domain.DriftInstalled:
+		return "kurulum farklı"
+	}
+	return string(issue)
+}
+
+// updateLabel yükseltme türünün tablodaki karşılığıdır (1.0 öncesi kırıcı değişiklikler ayrıca belirtilir)
+func updateLabel(d domain.OutdatedDependency) string {
+	icons := map[domain.UpdateKind]string{
+		domain.UpdateMajor:      "🟥",
+		domain.UpdateMinor:      "🟨",
+		domain.UpdatePatch:      "🟩",
+		domain.UpdatePrerelease: "🟪",
+	}
+	icon, ok := icons[d.Update]
+	if !ok {
+		icon = "⬜"
+	}
+	label := icon + " " + updateKindName(d.Update)
+	if v, ok := service.ParseSemver(d.Current); ok && v.Major == 0 && d.Update == domain.UpdateMajor {
+		label += " (0.x)"
+	}
+	return label
+}
+
+// updateKindName yükseltme türünün kısa adı
+func updateKindName(k domain.UpdateKind) string {
+	switch k {
+	case domain.UpdatePrerelease:
+		return "ön sürüm"
+	case domain.UpdateMajor, domain.UpdateMinor, domain.UpdatePatch:
+		return string(k)
+	}
+	return "bilinmiyor"
+}
+
+// updateSummary yükseltme türlerinin sayısı (örn: "2 major, 5 minor, 8 patch")
+func updateSummary(deps []domain.OutdatedDependency) string {
+	counts := make(map[domain.UpdateKind]int)
+	for _, d := range deps {
+		counts[d.Update]++
+	}
+	var parts []string
+	for _, k := range []domain.UpdateKind{domain.UpdateMajor, domain.UpdateMinor, domain.UpdatePatch, domain.UpdatePrerelease, domain.UpdateUnknown} {
+		if counts[k] > 0 {
+			parts = append(parts, fmt.Sprintf("%d %s", counts[k], updateKindName(k)))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// orDash boş hücreleri "-" ile gösterir
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
+
+func (m *MainModel) doctorView() string {
+	var b strings.Builder
+	greyStyle := lipgloss.NewStyle().Foreground(ColorGrey)
+
+	b.WriteString("\n" + m.doctorTabs() + "\n")
+	title := "🩺 " + m.Selected.Name + " İçin Doktor Raporu"
+	if m.DoctorOffline {
+		title = "🩺 " + m.Selected.Name + " İçin Kilit Dosyası Raporu (offline)"
+	}
+	if r := m.DoctorReport; r != nil && len(r.Sources) > 0 {
+		title += " (" + strings.Join(r.Sources, ", ") + ")"
+	}
+	b.WriteString("\n" + HeaderStyle.Render(title) + "\n")
+
+	switch {
+	case m.DoctorLoading && m.DoctorOffline:
+		b.WriteString("\n" + m.Spinner.View() + " Kilit dosyaları okunuyor...\n")
+	case m.DoctorLoading:
+		b.WriteString("\n" + m.Spinner.View() + " Paketler kontrol ediliyor...\n")
+	case m.DoctorErr != nil:
+		b.WriteString("\n" + lipgloss.NewStyle().Foreground(ColorRed).Render("⚠️  Hata: "+m.DoctorErr.Error()) + "\n")
+	case len(m.Table.Rows()) == 0 && !m.DoctorOffline && m.DoctorReport != nil && len(m.DoctorReport.Deps) > 0:
+		b.WriteString(greyStyle.Render(fmt.Sprintf("%d güncellenebilir paket: %s", len(m.DoctorReport.Deps), updateSummary(m.DoctorReport.Deps))) + "\n")
+		b.WriteString("\n" + lipgloss.NewStyle().Foreground(ColorYellow).Render("Filtreye ("+strings.Join(m.doctorFilters(), ", ")+") uyan paket yok") + "\n")
+	case len(m.Table.Rows()) == 0 && m.DoctorOffline:
+		b.WriteString("\n" + lipgloss.NewStyle().Foreground(ColorGreen).Render("✅ Kilit dosyaları manifest ve node_modules ile uyumlu!") + "\n")
+	case len(m.Table.Rows()) == 0:
+		b.WriteString("\n" + lipgloss.NewStyle().Foreground(ColorGreen).Render("✅ Tüm paketler güncel!") + "\n")
+	case m.DoctorOffline:
+		b.WriteString(greyStyle.Render(fmt.Sprintf("%d uyumsuz paket", len(m.Table.Rows()))) + "\n")
+		b.WriteString(m.Table.View() + "\n")
+	default:
+		deps := m.DoctorReport.Deps
+		summary := fmt.Sprintf("%d güncellenebilir paket: %s", len(deps), updateSummary(deps))
+		if f := m.doctorFilters(); len(f) > 0 {
+			summary += fmt.Sprintf(" · Filtre: %s (%d gösteriliyor)", strings.Join(f, ", "), len(m.Table.Rows()))
+		}
+		if n := m.selectedCount(); n > 0 {
+			summary += fmt.Sprintf(" · %d seçili", n)
+		}
+		b.WriteString(greyStyle.Render(summary) + "\n")
+		b.WriteString(m.Table.View() + "\n")
+	}
+	if m.UpgradeErr != nil {
+		b.WriteString(lipgloss.NewStyle().Foreground(ColorRed).Render("⚠️  Yükseltme: "+m.UpgradeErr.Error()) + "\n")
+	}
+
+	// Çalışmayan kaynaklar (örn: cargo-outdated kurulu değil) raporu engellemez
+	if r := m.DoctorReport; r != nil {
+		for _, e := range r.Errors {
+			b.WriteString(lipgloss.NewStyle().Foreground(ColorYellow).Render("⚠️  "+e) + "\n")
+		}
+	}
+
+	content := lipgloss.NewStyle().PaddingLeft(2).Render(b.String())
+	mode := "Offline"
+	if m.DoctorOffline {
+		mode = "Online"
+	}
+	pairs := []string{"↑↓", "Gezin"}
+	if m.canUpgrade() {
+		pairs = append(pairs, "Space", "Seç", "w/l", "Wanted/Latest", "m/d/i", "Major/Dev/Doğrudan")
+	}
+	pairs = append(pairs, "r", "Yeniden Kontrol", "o", mode, "Tab", "Güvenlik", "Esc", "Geri Dön")
+	return content + "\n  " + m.renderFooter(pairs...)
+}
@@ -0,0 +1,269 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"devterminal/pkg/domain"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// historyRerunMsg geçmişten yeniden çalıştırılan başlatma/araç kaydı açıldığında gönderilir
+type historyRerunMsg struct {
+	err error
+}
+
+// openHistory çalıştırma geçmişi ekranını açar; proje seçiliyse sadece onun kayıtları gösterilir
+func (m *MainModel) openHistory() {
+	m.State = StateHistory
+	m.HistoryCursor = 0
+	m.HistoryAllProjects = m.Selected == nil
+	m.HistoryErr = nil
+	m.HistoryEntries = m.Launcher.History.Entries()
+}
+
+// visibleHistory filtreye göre gösterilecek kayıtları döndürür
+func (m *MainModel) visibleHistory() []domain.HistoryEntry {
+	if m.HistoryAllProjects || m.Selected == nil {
+		return m.HistoryEntries
+	}
+	var out []domain.HistoryEntry
+	for _, e := range m.HistoryEntries {
+		if strings.EqualFold(e.Project, m.Selected.Path) {
+			out = append(out, e)
+		}
+	}
+	return out
+}
+
+// historyRunning listede hâlâ çalışan kayıt var mı (ekran canlı yenilensin mi)
+func (m *MainModel) historyRunning() bool {
+	for _, e := range m.visibleHistory() {
+		if e.Status == domain.HistoryRunning {
+			return true
+		}
+	}
+	return false
+}
+
+// projectByPath taranan projeler arasında yolu eşleşen projeyi döndürür
+func (m *MainModel) projectByPath(path string) *domain.Project {
+	for i := range m.Projects {
+		if strings.EqualFold(m.Projects[i].Path, path) {
+			return &m.Projects[i]
+		}
+	}
+	return nil
+}
+
+func (m *MainModel) updateHistory(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	entries := m.visibleHistory()
+
+	switch msg.String() {
+	case "esc":
+		m.State = StateProjectActions
+		if m.Selected == nil {
+			m.State = StateProjectSelect
+		}
+		return m, nil
+	case "q":
+		return m, tea.Quit
+	case "up", "k":
+		if m.HistoryCursor > 0 {
+			m.HistoryCursor--
+		}
+	case "down", "j":
+		if m.HistoryCursor < len(entries)-1 {
+			m.HistoryCursor++
+		}
+	case "f", "tab":
+		if m.Selected != nil {
+			m.HistoryAllProjects = !m.HistoryAllProjects
+			m.HistoryCursor = 0
+		}
+	case "enter", "r":
+		if m.HistoryCursor < len(entries) {
+			return m, m.rerunHistory(entries[m.HistoryCursor])
+		}
+	}
+	return m, nil
+}
+
+// rerunHistory kaydı türüne göre tekrar çalıştırır
+func (m *MainModel) rerunHistory(e domain.HistoryEntry) tea.Cmd {
+	m.HistoryErr = nil
+	p := m.projectByPath(e.Project)
+
+	switch e.Kind {
+	case domain.HistoryTask, domain.HistoryPipeline:
+		if p == nil {
+			m.HistoryErr = fmt.Errorf("%s projesi bulunamadı", e.ProjectName)
+			return nil
+		}
+		m.Selected = p
+		m.ScriptReturn = StateHistory
+		if e.Kind == domain.HistoryTask {
+			// Görevler sonucu görülsün diye satır içi çalışır
+			return m.runTaskInline(domain.Task{Name: e.Name, Source: e.Target, Dir: e.Dir, Command: e.Command, Run: e.Command})
+		}
+		for _, pl := range m.Launcher.Pipelines(p) {
+			if pl.Name == e.Target {
+				return m.runPipeline(pl)
+			}
+		}
+		m.HistoryErr = fmt.Errorf("%s pipeline'ı artık tanımlı değil", e.Target)
+		return nil
+
+	case domain.HistoryLaunch:
+		// Proje başlatmaları güncel komutlarla ve seçili hedefle (terminal/gömülü) tekrarlanır
+		if e.Target != "" && p != nil {
+			m.Selected = p
+			m.State = StateProjectActions
+			m.updateLastOpened(p.Path)
+			return m.launchCmd(e.Target)
+		}
+		// Alt proje ve servis başlatmaları tüm adımlarıyla, aynı sıra ve hazır olma kontrolleriyle tekrarlanır
+		if e.Launch == domain.LaunchSubProjects || e.Launch == domain.LaunchServices {
+			if p == nil {
+				m.HistoryErr = fmt.Errorf("%s projesi bulunamadı", e.ProjectName)
+				return nil
+			}
+			plan, err := m.Launcher.ReplayPlan(p, e)
+			if err == nil && len(plan.Steps) == 0 {
+				err = fmt.Errorf("%s için başlatılacak bir komut bulunamadı", strings.Join(e.Targets, ", "))
+			}
+			if err != nil {
+				m.HistoryErr = err
+				return nil
+			}
+			m.Selected = p
+			m.State = StateProjectActions
+			m.updateLastOpened(p.Path)
+			return m.startOrderedLaunch(p, plan)
+		}
+	}
+
+	if p != nil {
+		m.Selected = p
+	}
+	return func() tea.Msg {
+		err := m.Launcher.Rerun(e)
+		if e.Embedded && e.Kind != domain.HistoryTool {
+			return processStartedMsg{err: err}
+		}
+		return historyRerunMsg{err: err}
+	}
+}
+
+// historyStatusLabel kaydın sonucu için ikon ve renkli etiket döndürür
+func historyStatusLabel(e domain.HistoryEntry) string {
+	d := formatUptime(e.Duration())
+	switch e.Status {
+	case domain.HistoryRunning:
+		return lipgloss.NewStyle().Foreground(ColorCyan).Render("⏳ Çalışıyor")
+	case domain.HistoryPassed:
+		return lipgloss.NewStyle().Foreground(ColorGreen).Render("✅ exit 0 · " + d)
+	case domain.HistoryStopped:
+		return lipgloss.NewStyle().Foreground(ColorYellow).Render("■ Durduruldu · " + d)
+	case domain.HistoryFailed:
+		if e.ExitCode == -1 && e.DurationMs == 0 {
+			return lipgloss.NewStyle().Foreground(ColorRed).Render("❌ Başlatılamadı")
+		}
+		return lipgloss.NewStyle().Foreground(ColorRed).Render(fmt.Sprintf("❌ exit %d · %s", e.ExitCode, d))
+	}
+	return lipgloss.NewStyle().Foreground(ColorGrey).Render("↗ Terminalde açıldı")
+}
+
+// historyKindIcon kayıt türü için ikon döndürür
+func historyKindIcon(k domain.HistoryKind) string {
+	switch k {
+	case domain.HistoryLaunch:
+		return "🚀"
+	case domain.HistoryTask:
+		return "📜"
+	case domain.HistoryTool:
+		return "🛠️"
+	case domain.HistoryPipeline:
+		return "🔗"
+	}
+	return "•"
+}
+
+// historyTime bugünün kayıtlarında sadece saati, diğerlerinde tarihi de yazar
+func historyTime(t time.Time) string {
+	now := time.Now()
+	if t.YearDay() == now.YearDay() && t.Year() == now.Year() {
+		return t.Format("15:04:05")
+	}
+	return t.Format("02.01 15:04")
+}
+
+func (m *MainModel) historyView() string {
+	var b strings.Builder
+	greyStyle := lipgloss.NewStyle().Foreground(ColorGrey)
+
+	scope := "Tüm Projeler"
+	if !m.HistoryAllProjects && m.Selected != nil {
+		scope = m.Selected.Name
+	}
+	b.WriteString("\n" + HeaderStyle.Render("🕘 ÇALIŞTIRMA GEÇMİŞİ — "+scope) + "\n")
+	b.WriteString(greyStyle.Render(strings.Repeat("─", 40)) + "\n")
+
+	entries := m.visibleHistory()
+	if len(entries) == 0 {
+		b.WriteString(greyStyle.Render("Henüz kayıt yok. Başlatılan projeler, görevler ve araçlar burada listelenir.") + "\n")
+	}
+
+	// İmleci görünür tutacak şekilde kaydır
+	rows := m.Height - 12
+	if rows < 5 {
+		rows = 5
+	}
+	first := 0
+	if m.HistoryCursor >= rows {
+		first = m.HistoryCursor - rows + 1
+	}
+	for i := first; i < len(entries) && i < first+rows; i++ {
+		e := entries[i]
+		cursor := "  "
+		nameStyle := lipgloss.NewStyle().Foreground(ColorWhite)
+		if i == m.HistoryCursor {
+			cursor = lipgloss.NewStyle().Foreground(ColorPurple).Render("▸ ")
+			nameStyle = nameStyle.Foreground(ColorPurple).Bold(true)
+		}
+		name := e.Name
+		if m.HistoryAllProjects && e.ProjectName != "" {
+			name = e.ProjectName + " › " + name
+		}
+		b.WriteString(fmt.Sprintf("%s%s %s %s %s\n",
+			cursor,
+			greyStyle.Width(12).Render(historyTime(e.Time)),
+			historyKindIcon(e.Kind),
+			nameStyle.Width(36).Render(name),
+			historyStatusLabel(e),
+		))
+	}
+
+	// Seçili kaydın komutu ve dizini
+	if m.HistoryCursor < len(entries) {
+		e := entries[m.HistoryCursor]
+		b.WriteString("\n" + lipgloss.NewStyle().Foreground(ColorCyan).Render("▶ "+e.Command) + "\n")
+		b.WriteString(greyStyle.Render("📂 "+e.Dir) + "\n")
+	}
+	if m.HistoryErr != nil {
+		b.WriteString(lipgloss.NewStyle().Foreground(ColorRed).Render("⚠️  "+m.HistoryErr.Error()) + "\n")
+	}
+
+	content := lipgloss.NewStyle().PaddingLeft(2).Render(b.String())
+	pairs := []string{"↑↓", "Seç", "Enter/r", "Tekrar Çalıştır"}
+	if m.Selected != nil && m.HistoryAllProjects {
+		pairs = append(pairs, "f", "Sadece Bu Proje")
+	} else if m.Selected != nil {
+		pairs = append(pairs, "f", "Tüm Projeler")
+	}
+	pairs = append(pairs, "Esc", "Geri")
+	return content + "\n  " + m.renderFooter(pairs...)
+}
@@ -0,0 +1,233 @@
+package ui
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"devterminal/pkg/domain"
+	"devterminal/pkg/service"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// importsMsg import analizi bittiğinde gönderilir
+type importsMsg struct {
+	report *service.ImportReport
+	err    error
+}
+
+// importFilters f tuşuyla sırayla geçilen sorun türleri ("" = hepsi)
+var importFilters = []domain.ImportIssueKind{"", domain.ImportMissing, domain.ImportMisplaced, domain.ImportUnused}
+
+// openImports import sekmesini açar ve kaynak dosyaları taramaya başlar
+func (m *MainModel) openImports() tea.Cmd {
+	m.State = StateImportCheck
+	m.ImportReport = nil
+	m.ImportErr = nil
+	m.ImportLoading = true
+	m.importsFor = m.Selected.Path // Analiz ağa çıkmadığı için offline moddan bağımsızdır
+	m.ImportTable.SetRows([]table.Row{})
+	return tea.Batch(m.Spinner.Tick, m.importsCmd())
+}
+
+func (m *MainModel) importsCmd() tea.Cmd {
+	p := m.Selected
+	return func() tea.Msg {
+		report, err := m.Doctor.CheckImports(p)
+		return importsMsg{report: report, err: err}
+	}
+}
+
+// applyImportReport sonuçları tabloya yükler
+func (m *MainModel) applyImportReport(msg importsMsg) {
+	m.ImportLoading = false
+	m.ImportReport = msg.report
+	m.ImportErr = msg.err
+	m.refreshImportRows()
+}
+
+// refreshImportRows filtreye uyan sorunları tabloya yükler
+func (m *MainModel) refreshImportRows() {
+	m.ImportRows = nil
+	if m.ImportReport == nil {
+		m.ImportTable.SetRows([]table.Row{})
+		return
+	}
+	rows := make([]table.Row, 0, len(m.ImportReport.Issues))
+	for _, issue := range m.ImportReport.Issues {
+		if m.ImportFilter != "" && issue.Issue != m.ImportFilter {
+			continue
+		}
+		m.ImportRows = append(m.ImportRows, issue)
+		file := orDash(issue.File)
+		if issue.Files > 1 {
+			file += fmt.Sprintf(" (+%d)", issue.Files-1)
+		}
+		rows = append(rows, table.Row{
+			ecosystemIcon(issue.Ecosystem) + " " + issue.Ecosystem, issue.Name, importIssueLabel(issue.Issue), importSectionLabel(issue), file,
+		})
+	}
+	m.ImportTable.SetRows(rows)
+	m.ImportTable.SetCursor(0)
+	m.resizeImportTable()
+}
+
+// resizeImportTable tabloyu ekran yüksekliğine sığdırır (altta seçili sorunun açıklaması için yer bırakır)
+func (m *MainModel) resizeImportTable() {
+	h := m.Height - 15
+	if n := len(m.ImportTable.Rows()) + 1; n < h {
+		h = n
+	}
+	if h < 3 {
+		h = 3
+	}
+	m.ImportTable.SetHeight(h)
+}
+
+func (m *MainModel) updateImports(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.State = StateProjectActions
+		return m, nil
+	case "q":
+		return m, tea.Quit
+	case "tab":
+		return m, m.switchDoctorTab()
+	case "r":
+		if !m.ImportLoading {
+			return m, m.openImports()
+		}
+		return m, nil
+	case "f":
+		// Tümü -> tanımsız -> yanlış bölüm -> kullanılmıyor
+		for i, f := range importFilters {
+			if f == m.ImportFilter {
+				m.ImportFilter = importFilters[(i+1)%len(importFilters)]
+				break
+			}
+		}
+		m.refreshImportRows()
+		return m, nil
+	}
+	var cmd tea.Cmd
+	m.ImportTable, cmd = m.ImportTable.Update(msg)
+	return m, cmd
+}
+
+func newImportTable() table.Model {
+	t := newTable()
+	t.SetColumns([]table.Column{
+		{Title: "Ekosistem", Width: 10},
+		{Title: "Paket", Width: 28},
+		{Title: "Sorun", Width: 18},
+		{Title: "Bölüm", Width: 16},
+		{Title: "Dosya", Width: 30},
+	})
+	return t
+}
+
+// importIssueLabel sorun türünün tablodaki karşılığıdır
+func importIssueLabel(kind domain.ImportIssueKind) string {
+	switch kind {
+	case domain.ImportMissing:
+		return "🟥 tanımsız import"
+	case domain.ImportMisplaced:
+		return "🟨 yanlış bölüm"
+	case domain.ImportUnused:
+		return "⬜ kullanılmıyor"
+	}
+	return string(kind)
+}
+
+// importSectionLabel bağımlılığın bulunduğu ve olması gereken bölümü gösterir (örn: "dev → prod")
+func importSectionLabel(issue domain.ImportIssue) string {
+	switch {
+	case issue.Issue == domain.ImportMisplaced:
+		return issue.Section + " → " + issue.Expected
+	case issue.Issue == domain.ImportMissing:
+		return "→ " + issue.Expected
+	}
+	return orDash(issue.Section)
+}
+
+// importHint seçili sorunun nasıl düzeltileceğini açıklar
+func importHint(issue domain.ImportIssue) string {
+	switch issue.Ecosystem + "/" + string(issue.Issue) {
+	case "go/missing":
+		return "go.mod'da bu modülü sağlayan bir require yok: go get " + issue.Name
+	case "go/misplaced":
+		return "Doğrudan import ediliyor ama // indirect işaretli: go mod tidy ile düzeltin"
+	case "go/unused":
+		return "Hiçbir paket import etmiyor: go mod tidy ile kaldırılabilir"
+	}
+	switch issue.Issue {
+	case domain.ImportMissing:
+		return fmt.Sprintf("Manifestte tanımlı değil; %s bağımlılığı olarak ekleyin (%d dosya)", issue.Expected, issue.Files)
+	case domain.ImportMisplaced:
+		if issue.Expected == "prod" {
+			return "Üretim kodunda kullanılıyor; dev bağımlılıkları üretim kurulumunda yüklenmez"
+		}
+		return "Sadece testlerde / yapılandırmada kullanılıyor; dev bağımlılığı olarak taşınabilir"
+	}
+	return "Hiçbir dosyada import edilmiyor; script ve yapılandırma dosyalarında da geçmiyor"
+}
+
+func (m *MainModel) importsView() string {
+	var b strings.Builder
+	greyStyle := lipgloss.NewStyle().Foreground(ColorGrey)
+
+	b.WriteString("\n" + m.doctorTabs() + "\n")
+	title := "🔎 " + m.Selected.Name + " İçin Import Analizi"
+	if r := m.ImportReport; r != nil && len(r.Sources) > 0 {
+		title += " (" + strings.Join(r.Sources, ", ") + ")"
+	}
+	b.WriteString("\n" + HeaderStyle.Render(title) + "\n")
+
+	switch {
+	case m.ImportLoading:
+		b.WriteString("\n" + m.Spinner.View() + " Kaynak dosyalardaki import'lar taranıyor...\n")
+	case m.ImportErr != nil:
+		b.WriteString("\n" + lipgloss.NewStyle().Foreground(ColorRed).Render("⚠️  Hata: "+m.ImportErr.Error()) + "\n")
+	case len(m.ImportReport.Issues) == 0:
+		b.WriteString("\n" + lipgloss.NewStyle().Foreground(ColorGreen).Render(fmt.Sprintf("✅ Tüm bağımlılıklar kullanılıyor ve tanımlı! (%d dosya tarandı)", m.ImportReport.Files)) + "\n")
+	default:
+		counts := make(map[domain.ImportIssueKind]int)
+		for _, issue := range m.ImportReport.Issues {
+			counts[issue.Issue]++
+		}
+		summary := fmt.Sprintf("%d dosya tarandı · %d tanımsız, %d yanlış bölümde, %d kullanılmıyor",
+			m.ImportReport.Files, counts[domain.ImportMissing], counts[domain.ImportMisplaced], counts[domain.ImportUnused])
+		if m.ImportFilter != "" {
+			summary += " · Filtre: " + strings.SplitN(importIssueLabel(m.ImportFilter), " ", 2)[1]
+		}
+		b.WriteString(greyStyle.Render(summary) + "\n")
+
+		if len(m.ImportRows) == 0 {
+			b.WriteString("\n" + lipgloss.NewStyle().Foreground(ColorYellow).Render("Filtreye uyan sorun yok") + "\n")
+			break
+		}
+		b.WriteString(m.ImportTable.View() + "\n")
+
+		// Seçili sorunun açıklaması ve manifestin bulunduğu klasör
+		if i := m.ImportTable.Cursor(); i >= 0 && i < len(m.ImportRows) {
+			issue := m.ImportRows[i]
+			b.WriteString(lipgloss.NewStyle().Bold(true).Render(importHint(issue)) + "\n")
+			if rel, err := filepath.Rel(m.Selected.Path, issue.Dir); err == nil && rel != "." {
+				b.WriteString(greyStyle.Render("📁 "+filepath.ToSlash(rel)) + "\n")
+			}
+		}
+	}
+
+	if r := m.ImportReport; r != nil {
+		for _, e := range r.Errors {
+			b.WriteString(lipgloss.NewStyle().Foreground(ColorYellow).Render("⚠️  "+e) + "\n")
+		}
+	}
+
+	content := lipgloss.NewStyle().PaddingLeft(2).Render(b.String())
+	pairs := []string{"↑↓", "Gezin", "f", "Filtre", "r", "Yeniden Tara", "Tab", "Doktor", "Esc", "Geri Dön"}
+	return content + "\n  " + m.renderFooter(pairs...)
+}
@@ -0,0 +1,237 @@
+package ui
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"devterminal/pkg/config"
+	"devterminal/pkg/service"
+
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// matrixMsg projeler arası bağımlılık matrisi hazır olduğunda gönderilir
+type matrixMsg struct {
+	matrix *service.DependencyMatrix
+}
+
+// openMatrix taranan tüm projelerin manifestlerinden matrisi oluşturur
+func (m *MainModel) openMatrix() tea.Cmd {
+	m.State = StateDependencyMatrix
+	m.Matrix = nil
+	m.MatrixLoading = true
+	m.MatrixStatus = ""
+	m.MatrixTable.SetRows([]table.Row{})
+	projects := m.Projects
+	return tea.Batch(m.Spinner.Tick, func() tea.Msg {
+		return matrixMsg{matrix: service.BuildMatrix(projects)}
+	})
+}
+
+// refreshMatrixRows arama ve filtreye uyan bağımlılıkları tabloya yükler
+func (m *MainModel) refreshMatrixRows() {
+	if m.Matrix == nil {
+		m.MatrixRows = nil
+		m.MatrixTable.SetRows([]table.Row{})
+		return
+	}
+	m.MatrixRows = m.Matrix.Filter(m.MatrixSearch.Value(), m.MatrixDivergent)
+	rows := make([]table.Row, 0, len(m.MatrixRows))
+	for _, r := range m.MatrixRows {
+		name := "  " + r.Name
+		if r.Divergent {
+			name = "⚠ " + r.Name
+		}
+		var versions []string
+		for _, v := range r.Versions() {
+			versions = append(versions, fmt.Sprintf("%s ×%d", v.Version, v.Projects))
+		}
+		rows = append(rows, table.Row{
+			ecosystemIcon(r.Ecosystem) + " " + r.Ecosystem, name, fmt.Sprint(len(r.Cells)), strings.Join(versions, " · "),
+		})
+	}
+	m.MatrixTable.SetRows(rows)
+	m.MatrixTable.SetCursor(0)
+	m.resizeMatrixTable()
+}
+
+// resizeMatrixTable tabloyu ekranın üst yarısına sığdırır; altta seçili bağımlılığın proje listesi gösterilir
+func (m *MainModel) resizeMatrixTable() {
+	h := (m.Height - 12) / 2
+	if n := len(m.MatrixTable.Rows()) + 1; n < h {
+		h = n
+	}
+	if h < 3 {
+		h = 3
+	}
+	m.MatrixTable.SetHeight(h)
+	m.MatrixTable.SetColumns(matrixColumns(m.Width - 64))
+}
+
+// exportMatrix matrisi yapılandırma klasörüne CSV ve JSON olarak kaydeder
+func (m *MainModel) exportMatrix() {
+	dir, err := config.ConfigDir()
+	if err != nil {
+		m.MatrixStatus = "❌ " + err.Error()
+		return
+	}
+	paths, err := service.ExportMatrix(m.Matrix, filepath.Join(dir, "exports"))
+	if err != nil {
+		m.MatrixStatus = "❌ Dışa aktarılamadı: " + err.Error()
+		return
+	}
+	m.MatrixStatus = "💾 Kaydedildi: " + strings.Join(paths, ", ")
+}
+
+func (m *MainModel) updateMatrix(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	if m.MatrixSearching {
+		switch msg.String() {
+		case "enter", "esc", "tab":
+			m.MatrixSearching = false
+			m.MatrixSearch.Blur()
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m.MatrixSearch, cmd = m.MatrixSearch.Update(msg)
+		m.refreshMatrixRows()
+		return m, cmd
+	}
+
+	switch msg.String() {
+	case "esc":
+		m.State = StateProjectSelect
+		return m, nil
+	case "q":
+		return m, tea.Quit
+	case "/", "tab":
+		m.MatrixSearching = true
+		m.MatrixSearch.Focus()
+		return m, textinput.Blink
+	case "v":
+		m.MatrixDivergent = !m.MatrixDivergent
+		m.refreshMatrixRows()
+		return m, nil
+	case "r":
+		if !m.MatrixLoading {
+			return m, m.openMatrix()
+		}
+		return m, nil
+	case "e":
+		if m.Matrix != nil {
+			m.exportMatrix()
+		}
+		return m, nil
+	}
+	var cmd tea.Cmd
+	m.MatrixTable, cmd = m.MatrixTable.Update(msg)
+	return m, cmd
+}
+
+func newMatrixTable() table.Model {
+	t := newTable()
+	t.SetColumns(matrixColumns(0))
+	return t
+}
+
+// matrixColumns sürümler kolonunu kalan genişliğe yayar (en az 40)
+func matrixColumns(versionsWidth int) []table.Column {
+	if versionsWidth < 40 {
+		versionsWidth = 40
+	}
+	return []table.Column{
+		{Title: "Ekosistem", Width: 10},
+		{Title: "Paket", Width: 34},
+		{Title: "Proje", Width: 6},
+		{Title: "Sürümler (proje sayısı)", Width: versionsWidth},
+	}
+}
+
+func newMatrixSearch() textinput.Model {
+	ti := textinput.New()
+	ti.Prompt = "🔍 Paket: "
+	ti.Placeholder = "react, prisma..."
+	ti.Width = 40
+	return ti
+}
+
+func (m *MainModel) matrixView() string {
+	var b strings.Builder
+	greyStyle := lipgloss.NewStyle().Foreground(ColorGrey)
+
+	b.WriteString("\n" + HeaderStyle.Render(fmt.Sprintf("🧮 Bağımlılık Matrisi (%d proje)", len(m.Projects))) + "\n")
+
+	if m.MatrixLoading {
+		b.WriteString("\n" + m.Spinner.View() + " Manifest ve kilit dosyaları okunuyor...\n")
+		return lipgloss.NewStyle().PaddingLeft(2).Render(b.String()) + "\n  " + m.renderFooter("Esc", "Geri Dön")
+	}
+
+	if m.MatrixSearching || m.MatrixSearch.Value() != "" {
+		b.WriteString(m.MatrixSearch.View() + "\n")
+	}
+	divergent := 0
+	for _, r := range m.Matrix.Rows {
+		if r.Divergent {
+			divergent++
+		}
+	}
+	summary := fmt.Sprintf("%d bağımlılık · %d tanesinde projeler farklı sürüm kullanıyor (⚠)", len(m.Matrix.Rows), divergent)
+	if m.MatrixDivergent {
+		summary += " · Filtre: sadece farklı olanlar"
+	}
+	b.WriteString(greyStyle.Render(summary) + "\n")
+
+	if len(m.MatrixRows) == 0 {
+		b.WriteString("\n" + lipgloss.NewStyle().Foreground(ColorYellow).Render("Aramaya uyan bağımlılık yok") + "\n")
+	} else {
+		b.WriteString(m.MatrixTable.View() + "\n")
+
+		// Seçili bağımlılığı kullanan projeler; en yüksek sürümün major gerisindekiler işaretlenir
+		if i := m.MatrixTable.Cursor(); i >= 0 && i < len(m.MatrixRows) {
+			r := m.MatrixRows[i]
+			b.WriteString(lipgloss.NewStyle().Bold(true).Render(r.Name) + greyStyle.Render("  en yüksek: "+r.Highest) + "\n")
+			limit := m.Height - m.MatrixTable.Height() - 14
+			if limit < 3 {
+				limit = 3
+			}
+			for j, c := range r.Cells {
+				if j == limit {
+					b.WriteString(greyStyle.Render(fmt.Sprintf("  ... %d proje daha", len(r.Cells)-limit)) + "\n")
+					break
+				}
+				version := ValueStyle.Render(c.Version)
+				if c.Behind {
+					version = lipgloss.NewStyle().Foreground(ColorRed).Render("🔻 " + c.Version)
+				}
+				detail := ""
+				if c.Locked && c.Declared != c.Version {
+					detail = " (" + c.Declared + ")"
+				}
+				if c.Dev {
+					detail += " dev"
+				}
+				b.WriteString(fmt.Sprintf("  %-28s %s%s\n", c.Project, version, greyStyle.Render(detail)))
+			}
+		}
+	}
+
+	for _, e := range m.Matrix.Errors {
+		b.WriteString(lipgloss.NewStyle().Foreground(ColorYellow).Render("⚠️  "+e) + "\n")
+	}
+	if m.MatrixStatus != "" {
+		b.WriteString(greyStyle.Render(m.MatrixStatus) + "\n")
+	}
+
+	content := lipgloss.NewStyle().PaddingLeft(2).Render(b.String())
+	filter := "Sadece Farklı"
+	if m.MatrixDivergent {
+		filter = "Tümü"
+	}
+	if m.MatrixSearching {
+		return content + "\n  " + m.renderFooter("Enter", "Aramayı Bitir")
+	}
+	return content + "\n  " + m.renderFooter("↑↓", "Gezin", "/", "Ara", "v", filter, "e", "CSV/JSON Kaydet", "r", "Yenile", "Esc", "Geri Dön")
+}
@@ -1,6 +1,7 @@
 package ui
 
 import (
+	"context"
 	"devterminal/pkg/config"
 	"devterminal/pkg/domain"
 	"devterminal/pkg/service"
@@ -16,6 +17,7 @@ import (
 	"github.com/charmbracelet/bubbles/spinner"
 	"github.com/charmbracelet/bubbles/table"
 	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -36,6 +38,19 @@ const (
 	StateHealthScore
 	StateTaskRunner
 	StateSplash
+	StateProcesses        // Gömülü süreçler ve log paneli
+	StateServices         // Manifest servis seçimi
+	StateSubProjects      // Monorepo alt proje seçimi
+	StateScriptOutput     // Satır içi script çıktısı
+	StatePipeline         // Pipeline adımları ve çıktısı
+	StateHistory          // Çalıştırma geçmişi
+	StateLaunchPreview    // Başlatma komutu önizleme (dry-run)
+	StateSecurityAudit    // Güvenlik taraması (doktorun yanındaki sekme)
+	StateUpgradePreview   // Doktordan seçilen paketlerin yükseltme önizlemesi
+	StateDependencyMatrix // Projeler arası bağımlılık sürümleri
+	StateImportCheck      // Kullanılmayan / tanımsız bağımlılıklar (doktorun yanındaki sekme)
+	StateSBOM             // CycloneDX / SPDX yazılım malzeme listesi
+	StateDependencyPolicy // Projelerin doctor.policy kurallarına göre ihlalleri
 )
 
 type NgrokStep int
@@ -86,8 +101,62 @@ type MainModel struct {
 	Height int
 
 	// Feedback Flags
-	CopiedSuccess       bool
-	AllPackagesUpToDate bool
+	CopiedSuccess bool
+	DoctorReport  *service.DoctorReport // Son kontrolün sonucu
+	DoctorLoading bool
+	DoctorErr     error
+	DoctorOffline bool // Kilit dosyası karşılaştırması (ağa çıkmaz)
+	doctorFor     string
+
+	DoctorMajorOnly  bool // Filtreler: sadece major / devDependencies / doğrudan bağımlılıklar
+	DoctorDevOnly    bool
+	DoctorDirectOnly bool
+
+	// Doktordan paket yükseltme
+	DoctorSelected map[string]bool // Yükseltme için seçilen paketler (depKey)
+	Upgrade        *service.Upgrade
+	UpgradeErr     error
+
+	// Güvenlik taraması
+	AuditTable   table.Model
+	AuditReport  *service.AuditReport
+	AuditLoading bool
+	AuditErr     error
+	AuditByName  bool // false: önem derecesine göre sıralı
+	auditFor     string
+
+	// Import analizi (manifest <-> kaynak kod)
+	ImportTable   table.Model
+	ImportReport  *service.ImportReport
+	ImportRows    []domain.ImportIssue // Filtreden sonra tabloda görünen sorunlar
+	ImportLoading bool
+	ImportErr     error
+	ImportFilter  domain.ImportIssueKind // Boşsa tüm sorunlar
+	importsFor    string
+
+	// SBOM (yazılım malzeme listesi)
+	SBOM        *service.SBOM
+	SBOMLoading bool
+	SBOMErr     error
+	SBOMStatus  string // Son dışa aktarmanın sonucu
+
+	// Projeler arası bağımlılık matrisi
+	Matrix          *service.DependencyMatrix
+	MatrixTable     table.Model
+	MatrixRows      []service.MatrixRow // Arama ve filtreden sonra tabloda görünen satırlar
+	MatrixSearch    textinput.Model
+	MatrixSearching bool
+	MatrixDivergent bool // Sadece projelerin farklı sürüm kullandığı bağımlılıklar
+	MatrixLoading   bool
+	MatrixStatus    string // Son dışa aktarmanın sonucu
+
+	// Bağımlılık politikası (yasaklı paketler, alt sürümler, lisanslar)
+	PolicyTable   table.Model
+	PolicyReport  *service.PolicyReport
+	PolicyRows    []domain.PolicyViolation // Filtreden sonra tabloda görünen ihlaller
+	PolicyLoading bool
+	PolicyErr     error
+	PolicyFilter  domain.PolicyRule // Boşsa tüm kurallar
 
 	// Port Check
 	PortWarnings      []service.PortInfo
@@ -98,6 +167,65 @@ type MainModel struct {
 
 	// Splash
 	SplashProgress float64
+
+	// Supervisor (gömülü süreçler)
+	LogViewport   viewport.Model
+	ProcessCursor int
+	LogFollow     bool // Log paneli yeni satırları takip etsin mi
+	ProcessErr    error
+
+	// Sıralı başlatma (readiness probe'ları)
+	LaunchSteps   []service.LaunchProgress
+	launchProject string
+	launchStarted time.Time
+	launchRunning bool
+	launchCancel  context.CancelFunc
+	LaunchNotice  string // Sıralı başlatma yapılamadığında gösterilen bilgi
+
+	// Manifest servisleri
+	ServiceCursor     int
+	ServiceSelected   map[string]bool
+	ServicePortsInUse map[int]bool
+	ServiceErr        error
+
+	// Monorepo alt projeleri
+	SubProjectCursor   int
+	SubProjectSelected map[string]bool
+	SubProjectErr      error
+
+	// Satır içi script çalıştırma (Task Runner)
+	ScriptRun      *service.ManagedProcess
+	ScriptRunKey   string
+	ScriptViewport viewport.Model
+	ScriptFollow   bool
+	ScriptResults  map[string]scriptResult // Son sonuçlar (proje + script adı)
+	ScriptTask     domain.Task
+	ScriptReturn   SessionState // Çıktı ekranından Esc ile dönülecek ekran
+
+	// İzleme modu (satır içi görev dosya değişince yeniden çalışır)
+	ScriptWatchDesc   string
+	ScriptWatchLast   string // Son yeniden çalıştırmayı tetikleyen dosyalar
+	ScriptWatchErr    error
+	scriptWatchCancel context.CancelFunc
+
+	// Pipeline çalıştırma
+	PipelineRun    *pipelineRun
+	PipelineCursor int
+	PipelineErr    error
+
+	// Başlatma önizleme; DryRun açıksa (--dry-run) başlatma yerine önizleme gösterilir
+	DryRun      bool
+	PreviewMode string
+	Preview     service.LaunchPreview
+	LaunchErr   error // Son terminal/şablon başlatma hatası
+
+	// Çalıştırma geçmişi
+	HistoryEntries     []domain.HistoryEntry
+	HistoryCursor      int
+	HistoryAllProjects bool
+	HistoryErr         error
+
+	processTicking bool
 }
 
 type splashTickMsg time.Time
@@ -151,8 +279,9 @@ func NewMainModel() *MainModel {
 		TreeGen:         service.NewTreeGenerator(cfg),
 		Launcher:        service.NewLauncher(cfg),
 		Doctor:          service.NewDoctor(cfg),
+		DoctorOffline:   cfg.Doctor.Offline,
 		NgrokService:    service.NewNgrokService(cfg),
-		HealthService:   service.NewHealthService(),
+		HealthService:   service.NewHealthService(cfg),
 		NgrokPathInput:  tiPath,
 		NgrokPortInput:  tiPort,
 		NgrokTokenInput: tiToken,
@@ -162,16 +291,25 @@ func NewMainModel() *MainModel {
 		List:            list.New([]list.Item{}, list.NewDefaultDelegate(), 0, 0),
 		TaskRunnerList:  list.New([]list.Item{}, list.NewDefaultDelegate(), 0, 0),
 		Table:           newTable(),
+		AuditTable:      newAuditTable(),
+		ImportTable:     newImportTable(),
+		MatrixTable:     newMatrixTable(),
+		MatrixSearch:    newMatrixSearch(),
+		PolicyTable:     newPolicyTable(),
+		LogViewport:     newLogViewport(),
 	}
 }
 
-func newTable() table.Model {
-	columns := []table.Column{
-		{Title: "Paket", Width: 20},
-		{Title: "Mevcut", Width: 10},
-		{Title: "İstenen", Width: 10},
-		{Title: "Son", Width: 10},
+// Shutdown uygulamadan çıkarken gömülü süreçleri durdurur
+func (m *MainModel) Shutdown() {
+	if m.launchCancel != nil {
+		m.launchCancel()
 	}
+	m.Launcher.Supervisor.StopAll()
+}
+
+func newTable() table.Model {
+	columns := doctorColumns(false)
 	t := table.New(
 		table.WithColumns(columns),
 		table.WithFocused(true),
@@ -212,7 +350,21 @@ func (m *MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		// Takılan bir sıralı başlatma beklemesi Esc / Ctrl+C ile iptal edilir
+		if (msg.String() == "ctrl+c" || (msg.String() == "esc" && (m.State == StateProjectActions || m.State == StateProcesses))) &&
+			m.cancelOrderedLaunch() {
+			return m, nil
+		}
+
 		if msg.String() == "ctrl+c" {
+			// Satır içi script çalışıyorsa sadece onu iptal et
+			if m.State == StateScriptOutput && m.ScriptRun != nil && m.ScriptRun.Running() {
+				return m, m.cancelScript()
+			}
+			if m.State == StatePipeline && m.PipelineRun != nil && m.PipelineRun.Running {
+				m.PipelineRun.Cancel()
+				return m, nil
+			}
 			return m, tea.Quit
 		}
 
@@ -220,6 +372,7 @@ func (m *MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if msg.String() == "esc" {
 			if m.State == StateProjectActions {
 				m.State = StateProjectSelect
+				m.LaunchNotice = ""
 				// Listeyi son açılanlara göre yeniden sırala
 				return m, func() tea.Msg { return projectMsg(m.Projects) }
 			}
@@ -273,13 +426,25 @@ func (m *MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 
 		case StateDependencyDoctor:
-			if msg.String() == "esc" {
-				m.State = StateProjectActions
-				return m, nil
-			}
-			if msg.String() == "q" {
-				return m, tea.Quit
-			}
+			return m.updateDoctor(msg)
+
+		case StateSecurityAudit:
+			return m.updateAudit(msg)
+
+		case StateImportCheck:
+			return m.updateImports(msg)
+
+		case StateSBOM:
+			return m.updateSBOM(msg)
+
+		case StateUpgradePreview:
+			return m.updateUpgradePreview(msg)
+
+		case StateDependencyMatrix:
+			return m.updateMatrix(msg)
+
+		case StateDependencyPolicy:
+			return m.updatePolicy(msg)
 
 		case StateHealthScore:
 			if msg.String() == "esc" {
@@ -292,6 +457,8 @@ func (m *MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 		case StateTaskRunner:
 			if msg.String() == "esc" {
+				// Task Runner'dan çıkınca izleme modu da kapanır
+				m.stopScriptWatch()
 				m.State = StateProjectActions
 				return m, nil
 			}
@@ -299,21 +466,65 @@ func (m *MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, tea.Quit
 			}
 
-			if msg.String() == "enter" {
+			if (msg.String() == "enter" || msg.String() == "i") && m.TaskRunnerList.FilterState() != list.Filtering {
+				// Pipeline'lar her zaman burada adım adım çalışır
+				if pi, ok := m.TaskRunnerList.SelectedItem().(pipelineItem); ok {
+					m.ScriptReturn = StateTaskRunner
+					return m, m.runPipeline(pi.pipeline)
+				}
+			}
+
+			if msg.String() == "enter" && m.TaskRunnerList.FilterState() != list.Filtering {
 				// Run selected script
-				i, ok := m.TaskRunnerList.SelectedItem().(scriptItem)
+				i, ok := m.TaskRunnerList.SelectedItem().(taskItem)
+				if ok && m.Config.EmbeddedLaunch {
+					p := *m.Selected
+					return m, func() tea.Msg {
+						_, err := m.Launcher.RunTaskSupervised(p, i.task)
+						return processStartedMsg{err: err}
+					}
+				}
 				if ok {
+					p := *m.Selected
 					return m, func() tea.Msg {
-						_ = m.Launcher.LaunchScript(*m.Selected, i.name, i.cmd)
+						_ = m.Launcher.LaunchTask(p, i.task)
 						return nil
 					}
 				}
 			}
+			if msg.String() == "i" && m.TaskRunnerList.FilterState() != list.Filtering {
+				// Scripti burada çalıştır, çıktıyı panelde göster
+				if i, ok := m.TaskRunnerList.SelectedItem().(taskItem); ok {
+					m.ScriptReturn = StateTaskRunner
+					return m, m.runTaskInline(i.task)
+				}
+			}
 
 			var cmd tea.Cmd
 			m.TaskRunnerList, cmd = m.TaskRunnerList.Update(msg)
 			return m, cmd
 
+		case StateProcesses:
+			return m.updateProcesses(msg)
+
+		case StateServices:
+			return m.updateServices(msg)
+
+		case StateSubProjects:
+			return m.updateSubProjects(msg)
+
+		case StateScriptOutput:
+			return m.updateScriptOutput(msg)
+
+		case StatePipeline:
+			return m.updatePipeline(msg)
+
+		case StateHistory:
+			return m.updateHistory(msg)
+
+		case StateLaunchPreview:
+			return m.updatePreview(msg)
+
 		case StateNgrok:
 			switch m.NgrokStep {
 			case NgrokMainMenu:
@@ -415,10 +626,8 @@ func (m *MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					port := m.NgrokPortInput.Value()
 					exe := m.NgrokService.GetExecutable() // Use resolved path
 					return m, func() tea.Msg {
-						// We need to quote the exe path in case of spaces
-						// wt command: wt new-tab ... cmd /k "path/to/ngrok" http 3000
-						c := exec.Command("wt.exe", "new-tab", "-d", ".", "--title", "Ngrok "+port, "cmd", "/k", "\""+exe+"\"", "http", port)
-						c.Start()
+						// Terminal backend exe yolunu tırnaklar (boşluklu yollar için)
+						_ = m.Launcher.LaunchNgrok(exe, port)
 						return nil
 					}
 				}
@@ -459,7 +668,7 @@ func (m *MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.State = StateProjectActions
 				// Son açılanları HEMEN güncelle (sync)
 				m.updateLastOpened(m.Selected.Path)
-				return m, func() tea.Msg { m.Launcher.LaunchProject(m.Selected, mode); return nil }
+				return m, m.launchCmd(mode)
 			case "n", "N", "esc":
 				// [Esc] Geri Dön
 				m.State = StateProjectActions
@@ -478,7 +687,7 @@ func (m *MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				m.State = StateProjectActions
 				// Son açılanları HEMEN güncelle (sync)
 				m.updateLastOpened(m.Selected.Path)
-				return m, func() tea.Msg { m.Launcher.LaunchProject(m.Selected, mode); return nil }
+				return m, m.launchCmd(mode)
 			case "2":
 				// [2] Açık olan portu kapat (Sadece öldür, başlatma)
 				for _, w := range m.PortWarnings {
@@ -504,7 +713,7 @@ func (m *MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 				// Son açılanları HEMEN güncelle (sync)
 				m.updateLastOpened(m.Selected.Path)
-				return m, func() tea.Msg { m.Launcher.LaunchProject(m.Selected, "frontend"); return nil }
+				return m, m.launchCmd("frontend")
 			case "2", "b":
 				// Port Check: Backend
 				warnings := service.CheckProjectPorts(false, true)
@@ -516,7 +725,7 @@ func (m *MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 				// Son açılanları HEMEN güncelle (sync)
 				m.updateLastOpened(m.Selected.Path)
-				return m, func() tea.Msg { m.Launcher.LaunchProject(m.Selected, "backend"); return nil }
+				return m, m.launchCmd("backend")
 			case "3", "l":
 				// Port Check: Full
 				warnings := service.CheckProjectPorts(true, true)
@@ -528,7 +737,36 @@ func (m *MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 				// Son açılanları HEMEN güncelle (sync)
 				m.updateLastOpened(m.Selected.Path)
-				return m, func() tea.Msg { m.Launcher.LaunchProject(m.Selected, "full"); return nil }
+				return m, m.launchCmd("full")
+			case "g", "G":
+				// Başlatma hedefini değiştir: Terminal <-> Gömülü (Supervisor)
+				m.Config.EmbeddedLaunch = !m.Config.EmbeddedLaunch
+				_ = config.SaveConfig(m.Config)
+				return m, nil
+			case "s", "S":
+				// Gömülü süreçler ekranı
+				m.ProcessErr = nil
+				return m, m.openProcesses()
+			case "8", "m":
+				// Manifest servisleri (.devterminal.yaml)
+				if len(m.Selected.Services) > 0 || m.Selected.ManifestError != "" {
+					m.openServices()
+				}
+				return m, nil
+			case "v", "V":
+				// Başlatma komutlarını çalıştırmadan göster
+				m.openPreview(m.defaultPreviewMode())
+				return m, nil
+			case "0", "r":
+				// Çalıştırma geçmişi (bu projenin kayıtları)
+				m.openHistory()
+				return m, m.ensureProcessTick()
+			case "9", "p":
+				// Monorepo alt projelerinden seçerek başlat
+				if len(service.SubProjects(m.Selected)) > 0 {
+					m.openSubProjects()
+				}
+				return m, nil
 			case "4":
 				// Ngrok Flow - Smart Skip
 				m.State = StateNgrok
@@ -550,60 +788,50 @@ func (m *MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					}
 					return contextMsg(tree)
 				}
-			// Shortcuts for Tools
-			case "f1":
-				if m.Selected.HasPrisma {
-					return m, func() tea.Msg { _ = m.Launcher.LaunchPrisma(m.Selected); return nil }
-				}
-			case "f2":
-				if m.Selected.HasDrizzle {
-					return m, func() tea.Msg { _ = m.Launcher.LaunchDrizzle(m.Selected); return nil }
-				}
-			case "f3":
-				if m.Selected.HasHasura {
-					return m, func() tea.Msg { _ = m.Launcher.LaunchHasura(m.Selected); return nil }
-				}
-			case "f4":
-				if m.Selected.HasSupabase {
-					return m, func() tea.Msg { _ = m.Launcher.LaunchSupabase(m.Selected); return nil }
-				}
-			case "f5":
-				if m.Selected.HasStorybook {
-					return m, func() tea.Msg { _ = m.Launcher.LaunchStorybook(m.Selected); return nil }
-				}
 			case "6":
 				// Doctor
-				m.State = StateDependencyDoctor
 				m.Err = nil
-				m.Table.SetRows([]table.Row{}) // Clear old results
-				m.AllPackagesUpToDate = false  // Reset flag
-				return m, tea.Batch(m.Spinner.Tick, m.checkDependenciesCmd())
+				return m, m.openDoctor()
+			case "a", "A":
+				// Güvenlik taraması
+				m.Err = nil
+				return m, m.openAudit()
+			case "i", "I":
+				// Kullanılmayan / tanımsız bağımlılıklar
+				m.Err = nil
+				return m, m.openImports()
+			case "o", "O":
+				// SBOM (CycloneDX / SPDX)
+				m.Err = nil
+				return m, m.openSBOM()
 
 			case "h", "H": // Hidden shortcut for health? No, let's stick to requested "
 				// Health Score Trigger
-				report := m.HealthService.CheckHealth(m.Selected.Path)
+				report := m.HealthService.CheckHealth(m.Selected)
 				m.HealthReport = &report
 				m.State = StateHealthScore
 				return m, nil
 			case "7", "t":
 				// Task Runner
-				if len(m.Selected.Scripts) == 0 {
-					return m, nil // Script yoksa işlem yapma
+				pipelines := m.Launcher.Pipelines(m.Selected)
+				if len(m.Selected.Tasks) == 0 && len(pipelines) == 0 {
+					return m, nil // Görev yoksa işlem yapma
 				}
 
+				// Pipeline'lar üstte, görevler scanner'da kaynağa göre gruplanmış ve sıralanmış geliyor
 				var items []list.Item
-				// Scriptleri listeye ekle
-				for name, cmd := range m.Selected.Scripts {
-					items = append(items, scriptItem{name: name, cmd: cmd})
+				for _, pl := range pipelines {
+					items = append(items, pipelineItem{pipeline: pl})
+				}
+				for _, t := range m.Selected.Tasks {
+					items = append(items, taskItem{task: t})
 				}
-				// Sort by name
-				sort.Slice(items, func(i, j int) bool {
-					return items[i].(scriptItem).name < items[j].(scriptItem).name
-				})
 
 				m.TaskRunnerList.SetItems(items)
-				m.TaskRunnerList.Title = "📜 " + m.Selected.Name + " Scriptleri"
-				m.TaskRunnerList.SetStatusBarItemName("Script", "Script")
+				m.refreshScriptItems()
+				m.PipelineErr = nil
+				m.TaskRunnerList.Title = "📜 " + m.Selected.Name + " Görevleri (" + taskSourceSummary(m.Selected.Tasks, len(pipelines)) + ")"
+				m.TaskRunnerList.SetStatusBarItemName("Görev", "Görev")
 				m.TaskRunnerList.FilterInput.Prompt = "🔍 Ara: "
 				m.TaskRunnerList.DisableQuitKeybindings()
 
@@ -629,6 +857,7 @@ func (m *MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 				m.TaskRunnerList.AdditionalShortHelpKeys = func() []key.Binding {
 					return []key.Binding{
+						key.NewBinding(key.WithKeys("i"), key.WithHelp("i", "Burada Çalıştır")),
 						key.NewBinding(
 							key.WithKeys("q"),
 							key.WithHelp(
@@ -655,6 +884,13 @@ func (m *MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, nil
 			case "q":
 				return m, tea.Quit
+			default:
+				// Araç kısayolları (F1 Prisma, F5 Storybook ve config'teki araçlar)
+				for _, t := range m.Selected.Tools {
+					if t.Key == msg.String() {
+						return m, m.launchToolCmd(t)
+					}
+				}
 			}
 		}
 
@@ -665,6 +901,12 @@ func (m *MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.List.SetHeight(msg.Height - 10)
 		m.TaskRunnerList.SetWidth(msg.Width)
 		m.TaskRunnerList.SetHeight(msg.Height - 5) // Use more space for task runner
+		m.resizeLogViewport()
+		m.resizeDoctorTable()
+		m.resizeAuditTable()
+		m.resizeImportTable()
+		m.resizeMatrixTable()
+		m.resizePolicyTable()
 
 	case projectMsg:
 		m.Projects = msg
@@ -800,6 +1042,20 @@ func (m *MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 						lipgloss.NewStyle().Foreground(lipgloss.Color("#bd93f9")).Render("Yenile"),
 					),
 				),
+				key.NewBinding(
+					key.WithKeys("m"),
+					key.WithHelp(
+						lipgloss.NewStyle().Foreground(lipgloss.Color("#bd93f9")).Render("m"),
+						lipgloss.NewStyle().Foreground(lipgloss.Color("#bd93f9")).Render("Bağımlılık Matrisi"),
+					),
+				),
+				key.NewBinding(
+					key.WithKeys("p"),
+					key.WithHelp(
+						lipgloss.NewStyle().Foreground(lipgloss.Color("#bd93f9")).Render("p"),
+						lipgloss.NewStyle().Foreground(lipgloss.Color("#bd93f9")).Render("Politika"),
+					),
+				),
 				key.NewBinding(
 					key.WithKeys("q"),
 					key.WithHelp(
@@ -843,26 +1099,108 @@ func (m *MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case copiedResetMsg:
 		m.CopiedSuccess = false
 
+	case processStartedMsg:
+		m.ProcessErr = msg.err
+		// Yeni başlatılan süreci seç
+		m.ProcessCursor = len(m.Launcher.Supervisor.Processes()) - 1
+		if m.State != StateProcesses {
+			cmds = append(cmds, m.openProcesses())
+		} else {
+			m.refreshLogViewport()
+		}
+
+	case processActionMsg:
+		m.ProcessErr = msg.err
+		m.refreshLogViewport()
+
+	case launchProgressMsg:
+		if msg.progress.Step < len(m.LaunchSteps) {
+			m.LaunchSteps[msg.progress.Step] = msg.progress
+		}
+		if msg.progress.Stage == service.StageStarting && m.State == StateProcesses {
+			// Yeni başlatılan süreci seç
+			m.ProcessCursor = len(m.Launcher.Supervisor.Processes()) - 1
+			m.LogFollow = true
+			m.refreshLogViewport()
+		}
+		cmds = append(cmds, waitLaunchProgress(msg.ch))
+
+	case scriptDoneMsg:
+		m.recordScriptResult(msg.key, msg.mp)
+		if m.State == StateScriptOutput && msg.mp == m.ScriptRun {
+			m.refreshScriptViewport()
+		}
+
+	case scriptWatchMsg:
+		if m.scriptWatchCancel != nil {
+			cmds = append(cmds, m.rerunWatchedScript(msg.files), waitScriptWatch(msg.ch))
+		}
+
+	case scriptRerunMsg:
+		if m.scriptWatchCancel != nil {
+			cmds = append(cmds, m.startTaskInline(m.ScriptTask))
+		}
+
+	case launchErrorMsg:
+		m.LaunchErr = msg.err
+
+	case historyRerunMsg:
+		m.HistoryErr = msg.err
+		m.HistoryEntries = m.Launcher.History.Entries()
+
+	case pipelineEventMsg:
+		m.applyPipelineEvent(msg.event)
+		cmds = append(cmds, waitPipelineEvent(m.PipelineRun.Key, msg.ch))
+
+	case matrixMsg:
+		m.MatrixLoading = false
+		m.Matrix = msg.matrix
+		m.refreshMatrixRows()
+
+	case pipelineFinishedMsg:
+		m.finishPipeline(msg.key)
+
+	case launchFinishedMsg:
+		m.launchRunning = false
+		m.launchCancel = nil
+
+	case processTickMsg:
+		// Sadece süreç durumunu gösteren ekranlarda yenilemeye devam et
+		m.processTicking = false
+		if m.wantsProcessTick() {
+			if m.State == StateProcesses {
+				m.resizeLogViewport()
+				m.refreshLogViewport()
+			}
+			if m.State == StateScriptOutput {
+				m.refreshScriptViewport()
+			}
+			if m.State == StatePipeline {
+				m.refreshPipelineViewport()
+			}
+			if m.State == StateHistory {
+				m.HistoryEntries = m.Launcher.History.Entries()
+			}
+			cmds = append(cmds, m.ensureProcessTick())
+		}
+
 	case errMsg:
 		m.Err = error(msg)
 		// Clear any ongoing operations
 
 	case doctorMsg:
-		if len(msg) == 0 {
-			// No outdated packages - keep table empty and set flag
-			m.Table.SetRows([]table.Row{})
-			m.AllPackagesUpToDate = true
-		} else {
-			// Outdated packages exist - populate table
-			rows := []table.Row{}
-			for pkg, info := range msg {
-				rows = append(rows, table.Row{pkg, info.Current, info.Wanted, info.Latest})
-			}
-			m.Table.SetRows(rows)
-			m.AllPackagesUpToDate = false
-		}
-		m.Err = nil // Clear any previous errors
-		// Tablo güncellendi
+		m.applyDoctorReport(msg)
+	case auditMsg:
+		m.applyAuditReport(msg)
+	case importsMsg:
+		m.applyImportReport(msg)
+	case sbomMsg:
+		m.SBOMLoading = false
+		m.SBOM, m.SBOMErr = msg.sbom, msg.err
+	case policyMsg:
+		m.PolicyLoading = false
+		m.PolicyReport, m.PolicyErr = msg.report, msg.err
+		m.refreshPolicyRows()
 
 	case splashTickMsg:
 		if m.State == StateSplash {
@@ -881,7 +1219,7 @@ func (m *MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	// Alt bileşenleri güncelle
 	switch m.State {
-	case StateScanning, StateNgrok, StateDependencyDoctor:
+	case StateScanning, StateNgrok, StateDependencyDoctor, StateSecurityAudit, StateImportCheck, StateSBOM, StateDependencyMatrix, StateDependencyPolicy:
 		m.Spinner, cmd = m.Spinner.Update(msg)
 		cmds = append(cmds, cmd)
 	case StateSplash:
@@ -901,6 +1239,16 @@ func (m *MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, tea.Batch(cmds...)
 		}
 
+		// "m" ile tüm projelerin bağımlılık matrisi
+		if key, ok := msg.(tea.KeyMsg); ok && key.String() == "m" && m.List.FilterState() != list.Filtering {
+			return m, m.openMatrix()
+		}
+
+		// "p" ile tüm projelerin bağımlılık politikası ihlalleri
+		if key, ok := msg.(tea.KeyMsg); ok && key.String() == "p" && m.List.FilterState() != list.Filtering {
+			return m, m.openPolicy()
+		}
+
 		// "Tab" ile filtreleme modu kapatma (Toggle)
 		if key, ok := msg.(tea.KeyMsg); ok && key.String() == "tab" && m.List.FilterState() == list.Filtering {
 			msg = tea.KeyMsg{Type: tea.KeyEsc}
@@ -928,12 +1276,13 @@ func (m *MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					m.Selected = i.project
 					if m.List.Title == "Bağımlılık Kontrolü İçin Proje Seç" {
 						// Doktoru çalıştır
-						m.State = StateDependencyDoctor
 						m.Err = nil
-						// Spinner başlat ve komutu tetikle
-						cmds = append(cmds, m.Spinner.Tick, m.checkDependenciesCmd())
+						cmds = append(cmds, m.openDoctor())
 					} else {
 						m.State = StateProjectActions // Alt menüye git
+						if m.wantsProcessTick() {
+							cmds = append(cmds, m.ensureProcessTick())
+						}
 					}
 				}
 			}
@@ -944,16 +1293,6 @@ func (m *MainModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, tea.Batch(cmds...)
 }
 
-func (m *MainModel) checkDependenciesCmd() tea.Cmd {
-	return func() tea.Msg {
-		res, err := m.Doctor.CheckDependencies(m.Selected.Path)
-		if err != nil {
-			return errMsg(err)
-		}
-		return doctorMsg(res)
-	}
-}
-
 // updateLastOpened proje açılma zamanını kaydeder (sync)
 func (m *MainModel) updateLastOpened(path string) {
 	if m.Config.LastOpened == nil {
@@ -1015,35 +1354,19 @@ func (m *MainModel) View() string {
 		return lipgloss.Place(m.Width, m.Height, lipgloss.Center, lipgloss.Center, box)
 
 	case StateDependencyDoctor:
-		footer := m.renderFooter("Esc", "Geri Dön")
-
-		// Show error if exists
-		if m.Err != nil {
-			errorMsg := lipgloss.NewStyle().
-				Foreground(lipgloss.Color("#ff5555")).
-				Render(fmt.Sprintf("\n  ⚠️  Hata: %s", m.Err.Error()))
-			return fmt.Sprintf("\n  %s İçin Doktor Raporu\n%s\n\n  %s", m.Selected.Name, errorMsg, footer)
-		}
-
-		// Show loading or results
-		var tableView string
-		if len(m.Table.Rows()) == 0 {
-			// Empty table - either loading or all up to date
-			if m.AllPackagesUpToDate {
-				// All packages are up to date
-				tableView = "\n  " + lipgloss.NewStyle().
-					Foreground(lipgloss.Color("#50fa7b")).
-					Render("✅ Tüm paketler güncel!")
-			} else {
-				// Still loading
-				tableView = "\n  " + m.Spinner.View() + " Paketler kontrol ediliyor..."
-			}
-		} else {
-			// Results arrived - show table
-			tableView = "\n" + m.Table.View()
-		}
-
-		return fmt.Sprintf("\n  %s İçin Doktor Raporu%s\n\n  %s", m.Selected.Name, tableView, footer)
+		return m.doctorView()
+	case StateSecurityAudit:
+		return m.auditView()
+	case StateImportCheck:
+		return m.importsView()
+	case StateSBOM:
+		return m.sbomView()
+	case StateUpgradePreview:
+		return m.upgradePreviewView()
+	case StateDependencyMatrix:
+		return m.matrixView()
+	case StateDependencyPolicy:
+		return m.policyView()
 	case StateNgrok:
 		return m.ngrokView()
 	case StateHealthScore:
@@ -1052,6 +1375,20 @@ func (m *MainModel) View() string {
 		return m.taskRunnerView()
 	case StateSplash:
 		return m.splashView()
+	case StateProcesses:
+		return m.processesView()
+	case StateServices:
+		return m.servicesView()
+	case StateSubProjects:
+		return m.subProjectsView()
+	case StateScriptOutput:
+		return m.scriptOutputView()
+	case StatePipeline:
+		return m.pipelineView()
+	case StateHistory:
+		return m.historyView()
+	case StateLaunchPreview:
+		return m.previewView()
 	}
 
 	return "Bilinmeyen Durum"
@@ -1060,7 +1397,6 @@ func (m *MainModel) View() string {
 // Msg types
 type errMsg error
 type contextMsg string
-type doctorMsg service.NpmOutdatedResult
 type ngrokInstalledMsg string // changed to string (path)
 type ngrokAuthMsg bool
 
@@ -1182,14 +1518,58 @@ func (i item) Title() string       { return i.title }
 func (i item) Description() string { return i.desc }
 func (i item) FilterValue() string { return i.project.Name }
 
-// Script Item Adapter
-type scriptItem struct {
-	name, cmd string
+// Task Item Adapter
+type taskItem struct {
+	task   domain.Task
+	result *scriptResult // Son satır içi çalıştırma sonucu
 }
 
-func (i scriptItem) Title() string       { return i.name }
-func (i scriptItem) Description() string { return i.cmd }
-func (i scriptItem) FilterValue() string { return i.name }
+func (i taskItem) Title() string { return taskSourceIcon(i.task.Source) + " " + i.task.Name }
+func (i taskItem) Description() string {
+	desc := "[" + i.task.Source + "] " + i.task.Command
+	if i.result != nil {
+		return i.result.label() + "  │  " + desc
+	}
+	return desc
+}
+func (i taskItem) FilterValue() string { return i.task.Source + " " + i.task.Name }
+
+// taskSourceIcon görev kaynağı için ikon döndürür
+func taskSourceIcon(source string) string {
+	icons := map[string]string{
+		"npm":      "📦",
+		"make":     "🔧",
+		"task":     "📋",
+		"just":     "⚡",
+		"composer": "🎼",
+		"deno":     "🦕",
+		"python":   "🐍",
+	}
+	if icon, ok := icons[source]; ok {
+		return icon
+	}
+	return "📜"
+}
+
+// taskSourceSummary liste başlığı için kaynak başına görev sayısını yazar (örn: "pipeline 2 · npm 5 · make 3")
+func taskSourceSummary(tasks []domain.Task, pipelines int) string {
+	var order []string
+	counts := make(map[string]int)
+	for _, t := range tasks {
+		if counts[t.Source] == 0 {
+			order = append(order, t.Source)
+		}
+		counts[t.Source]++
+	}
+	parts := make([]string, 0, len(order)+1)
+	if pipelines > 0 {
+		parts = append(parts, fmt.Sprintf("pipeline %d", pipelines))
+	}
+	for _, src := range order {
+		parts = append(parts, fmt.Sprintf("%s %d", src, counts[src]))
+	}
+	return strings.Join(parts, " · ")
+}
 
 func (m *MainModel) healthScoreView() string {
 	if m.HealthReport == nil {
@@ -1233,6 +1613,14 @@ func (m *MainModel) healthScoreView() string {
 		}
 	}
 
+	// Skora dahil edilmeyen kontroller
+	if len(m.HealthReport.Notes) > 0 {
+		rows = append(rows, "")
+		for _, note := range m.HealthReport.Notes {
+			rows = append(rows, lipgloss.NewStyle().Foreground(ColorGrey).Render("ℹ️  "+note))
+		}
+	}
+
 	footer := m.renderFooter("Esc", "Geri Dön")
 
 	content := lipgloss.JoinVertical(lipgloss.Left,
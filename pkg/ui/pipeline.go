@@ -0,0 +1,348 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"devterminal/pkg/domain"
+	"devterminal/pkg/service"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// pipelineEventMsg çalışan pipeline'dan gelen tek bir adım bildirimi
+type pipelineEventMsg struct {
+	event service.PipelineEvent
+	ch    <-chan service.PipelineEvent
+}
+
+// pipelineFinishedMsg pipeline bittiğinde (başarılı, başarısız veya iptal) gönderilir
+type pipelineFinishedMsg struct {
+	key string
+}
+
+// pipelineRun ekranda gösterilen pipeline çalıştırmasının durumu
+type pipelineRun struct {
+	Key      string
+	Pipeline domain.Pipeline
+	Units    []service.PipelineUnit
+	Steps    []service.PipelineEvent // Birim başına son durum
+	Started  time.Time
+	Finished time.Time
+	Running  bool
+	Cancel   context.CancelFunc
+	Upgrade  *service.Upgrade // Doktordan başlatılan paket yükseltmesi (tekrar çalıştırılamaz)
+}
+
+// passed tüm adımlar başarılı mı
+func (r *pipelineRun) passed() bool {
+	steps := r.Steps
+	if r.Upgrade != nil {
+		steps = steps[:r.Upgrade.Rollback] // Geri alma adımları sadece hata olursa çalışır
+	}
+	for _, s := range steps {
+		if s.Status != service.PipelinePassed {
+			return false
+		}
+	}
+	return true
+}
+
+// pipelineItem Task Runner listesinde pipeline satırı
+type pipelineItem struct {
+	pipeline domain.Pipeline
+	result   *scriptResult // Son çalıştırmanın özeti
+}
+
+func (i pipelineItem) Title() string { return "🔗 " + i.pipeline.Name }
+func (i pipelineItem) Description() string {
+	desc := "[pipeline] " + pipelineSummary(i.pipeline)
+	if i.result != nil {
+		return i.result.label() + "  │  " + desc
+	}
+	return desc
+}
+func (i pipelineItem) FilterValue() string { return "pipeline " + i.pipeline.Name }
+
+// pipelineSummary adımları tek satırda gösterir (örn: "lint → [test | vet] → build")
+func pipelineSummary(pl domain.Pipeline) string {
+	stepName := func(s domain.PipelineStep) string {
+		if s.Script != "" {
+			return s.Script
+		}
+		return s.Command
+	}
+	parts := make([]string, 0, len(pl.Steps))
+	for _, s := range pl.Steps {
+		if len(s.Parallel) == 0 {
+			parts = append(parts, stepName(s))
+			continue
+		}
+		names := make([]string, 0, len(s.Parallel))
+		for _, p := range s.Parallel {
+			names = append(names, stepName(p))
+		}
+		parts = append(parts, "["+strings.Join(names, " | ")+"]")
+	}
+	return strings.Join(parts, " → ")
+}
+
+func pipelineKey(projectPath, name string) string {
+	return projectPath + "\x00pipeline\x00" + name
+}
+
+func waitPipelineEvent(key string, ch <-chan service.PipelineEvent) tea.Cmd {
+	return func() tea.Msg {
+		ev, ok := <-ch
+		if !ok {
+			return pipelineFinishedMsg{key: key}
+		}
+		return pipelineEventMsg{event: ev, ch: ch}
+	}
+}
+
+// runPipeline pipeline'ı planlar, arka planda çalıştırır ve adım ekranına geçer
+func (m *MainModel) runPipeline(pl domain.Pipeline) tea.Cmd {
+	if m.PipelineRun != nil && m.PipelineRun.Running {
+		// Aynı anda tek pipeline
+		m.State = StatePipeline
+		return nil
+	}
+
+	p := m.Selected
+	m.PipelineErr = nil
+	units, err := m.Launcher.PlanPipeline(p, pl)
+	if err != nil {
+		// Task Runner listesinde durum satırı olarak göster
+		m.PipelineErr = err
+		return nil
+	}
+
+	run := &pipelineRun{
+		Key:      pipelineKey(p.Path, pl.Name),
+		Pipeline: pl,
+		Units:    units,
+		Steps:    make([]service.PipelineEvent, len(units)),
+		Started:  time.Now(),
+		Running:  true,
+	}
+	for i := range run.Steps {
+		run.Steps[i] = service.PipelineEvent{Unit: i, Status: service.PipelinePending}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	run.Cancel = cancel
+
+	m.PipelineRun = run
+	m.PipelineCursor = 0
+	m.ScriptViewport = viewport.New(0, 0)
+	m.ScriptFollow = true
+	m.State = StatePipeline
+	m.refreshPipelineViewport()
+
+	ch := make(chan service.PipelineEvent)
+	go func() {
+		defer cancel()
+		_ = m.Launcher.RunPipeline(ctx, p, pl.Name, units, ch)
+	}()
+	return tea.Batch(waitPipelineEvent(run.Key, ch), m.ensureProcessTick())
+}
+
+// applyPipelineEvent adım durumunu günceller; imleç çalışan veya başarısız adımı takip eder
+func (m *MainModel) applyPipelineEvent(ev service.PipelineEvent) {
+	run := m.PipelineRun
+	if run == nil || ev.Unit >= len(run.Steps) {
+		return
+	}
+	run.Steps[ev.Unit] = ev
+	if ev.Status == service.PipelineRunning || ev.Status == service.PipelineFailed {
+		if run.Steps[m.PipelineCursor].Status != service.PipelineFailed {
+			m.PipelineCursor = ev.Unit
+			m.ScriptFollow = true
+		}
+	}
+	m.refreshPipelineViewport()
+}
+
+// finishPipeline çalıştırmanın sonucunu saklar ve listedeki özeti günceller
+func (m *MainModel) finishPipeline(key string) {
+	run := m.PipelineRun
+	if run == nil || run.Key != key {
+		return
+	}
+	run.Running = false
+	run.Finished = time.Now()
+	if run.Upgrade != nil {
+		m.refreshPipelineViewport()
+		return
+	}
+
+	res := scriptResult{Status: service.ProcessExited, Duration: run.Finished.Sub(run.Started)}
+	if !run.passed() {
+		res.Status = service.ProcessStopped
+		for _, s := range run.Steps {
+			if s.Status == service.PipelineFailed {
+				res.Status = service.ProcessFailed
+				res.ExitCode = s.ExitCode
+				break
+			}
+		}
+	}
+	if m.ScriptResults == nil {
+		m.ScriptResults = make(map[string]scriptResult)
+	}
+	m.ScriptResults[key] = res
+	m.refreshScriptItems()
+	m.refreshPipelineViewport()
+}
+
+// refreshPipelineViewport seçili adımın loglarını panele yükler
+func (m *MainModel) refreshPipelineViewport() {
+	run := m.PipelineRun
+	m.ScriptViewport.Width = m.Width - 4
+	h := m.Height - 12 - len(run.Units)
+	if h < 3 {
+		h = 3
+	}
+	m.ScriptViewport.Height = h
+
+	step := run.Steps[m.PipelineCursor]
+	switch {
+	case step.Process != nil:
+		m.ScriptViewport.SetContent(strings.Join(step.Process.Logs.Lines(), "\n"))
+	case step.Err != nil:
+		m.ScriptViewport.SetContent("❌ " + step.Err.Error())
+	case step.Status == service.PipelineSkipped && run.Upgrade != nil && m.PipelineCursor >= run.Upgrade.Rollback:
+		m.ScriptViewport.SetContent("Yükseltme başarılı olduğu için geri alma gerekmedi.")
+	case step.Status == service.PipelineSkipped:
+		m.ScriptViewport.SetContent("Önceki adım başarısız olduğu için çalıştırılmadı.")
+	default:
+		m.ScriptViewport.SetContent("Sırası bekleniyor...")
+	}
+	if m.ScriptFollow {
+		m.ScriptViewport.GotoBottom()
+	}
+}
+
+func (m *MainModel) updatePipeline(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	run := m.PipelineRun
+
+	switch msg.String() {
+	case "esc":
+		// Pipeline arka planda devam eder, sonuç listede görünür
+		m.State = m.ScriptReturn
+		if m.State == StateHistory {
+			m.HistoryEntries = m.Launcher.History.Entries()
+		}
+		if run.Upgrade != nil && !run.Running && m.State == StateDependencyDoctor {
+			// Yükseltme sonrası sürümler değişti
+			return m, m.openDoctor()
+		}
+		return m, m.ensureProcessTick()
+	case "q":
+		return m, tea.Quit
+	case "up":
+		if m.PipelineCursor > 0 {
+			m.PipelineCursor--
+			m.ScriptFollow = true
+			m.refreshPipelineViewport()
+		}
+		return m, nil
+	case "down":
+		if m.PipelineCursor < len(run.Units)-1 {
+			m.PipelineCursor++
+			m.ScriptFollow = true
+			m.refreshPipelineViewport()
+		}
+		return m, nil
+	case "r":
+		if !run.Running && run.Upgrade == nil {
+			return m, m.runPipeline(run.Pipeline)
+		}
+		return m, nil
+	case "G", "end":
+		m.ScriptFollow = true
+		m.ScriptViewport.GotoBottom()
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.ScriptViewport, cmd = m.ScriptViewport.Update(msg)
+	m.ScriptFollow = m.ScriptViewport.AtBottom()
+	return m, cmd
+}
+
+// pipelineStatusLabel adım durumu için ikon ve renkli etiket döndürür
+func pipelineStatusLabel(s service.PipelineEvent) string {
+	switch s.Status {
+	case service.PipelineRunning:
+		return lipgloss.NewStyle().Foreground(ColorCyan).Render("⏳ Çalışıyor")
+	case service.PipelinePassed:
+		return lipgloss.NewStyle().Foreground(ColorGreen).Render("✅ " + formatUptime(s.Duration))
+	case service.PipelineFailed:
+		return lipgloss.NewStyle().Foreground(ColorRed).Render(fmt.Sprintf("❌ exit %d · %s", s.ExitCode, formatUptime(s.Duration)))
+	case service.PipelineSkipped:
+		return lipgloss.NewStyle().Foreground(ColorGrey).Render("⏭  Atlandı")
+	case service.PipelineStopped:
+		return lipgloss.NewStyle().Foreground(ColorYellow).Render("⏹  Durduruldu · " + formatUptime(s.Duration))
+	}
+	return lipgloss.NewStyle().Foreground(ColorGrey).Render("○ Bekliyor")
+}
+
+func (m *MainModel) pipelineView() string {
+	var b strings.Builder
+	run := m.PipelineRun
+	greyStyle := lipgloss.NewStyle().Foreground(ColorGrey)
+
+	b.WriteString("\n" + HeaderStyle.Render("🔗 "+m.Selected.Name+" › "+run.Pipeline.Name) + "\n")
+
+	var status string
+	switch {
+	case run.Running:
+		status = lipgloss.NewStyle().Foreground(ColorGreen).Render("● Çalışıyor") + "  ⏱ " + formatUptime(time.Since(run.Started))
+	case run.passed():
+		status = lipgloss.NewStyle().Foreground(ColorGreen).Render("✅ Tüm adımlar başarılı · " + formatUptime(run.Finished.Sub(run.Started)))
+	default:
+		status = lipgloss.NewStyle().Foreground(ColorRed).Render("❌ Pipeline durdu · " + formatUptime(run.Finished.Sub(run.Started)))
+		if run.Upgrade != nil {
+			status = lipgloss.NewStyle().Foreground(ColorRed).Render("❌ Yükseltme başarısız, değişiklikler geri alındı · " + formatUptime(run.Finished.Sub(run.Started)))
+		}
+	}
+	b.WriteString(status + "\n\n")
+
+	for i, u := range run.Units {
+		cursor := "  "
+		nameStyle := lipgloss.NewStyle().Foreground(ColorWhite)
+		if i == m.PipelineCursor {
+			cursor = lipgloss.NewStyle().Foreground(ColorPurple).Render("▸ ")
+			nameStyle = nameStyle.Foreground(ColorPurple).Bold(true)
+		}
+		// Paralel gruplar aynı numarayla gösterilir
+		b.WriteString(fmt.Sprintf("%s%s %s %s\n",
+			cursor,
+			greyStyle.Width(4).Render(fmt.Sprintf("%d.", u.Group+1)),
+			nameStyle.Width(28).Render(u.Name),
+			pipelineStatusLabel(run.Steps[i]),
+		))
+	}
+	b.WriteString("\n")
+
+	b.WriteString(lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(ColorGrey).
+		Render(m.ScriptViewport.View()) + "\n")
+
+	content := lipgloss.NewStyle().PaddingLeft(2).Render(b.String())
+	var footer string
+	if run.Running {
+		footer = m.renderFooter("↑↓", "Adım", "ctrl+c", "İptal", "j/k", "Kaydır", "Esc", "Listeye Dön")
+	} else if run.Upgrade != nil {
+		footer = m.renderFooter("↑↓", "Adım", "j/k", "Kaydır", "Esc", "Doktora Dön")
+	} else {
+		footer = m.renderFooter("↑↓", "Adım", "r", "Tekrar Çalıştır", "j/k", "Kaydır", "Esc", "Listeye Dön")
+	}
+	return content + "\n  " + footer
+}
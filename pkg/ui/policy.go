@@ -0,0 +1,176 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"devterminal/pkg/domain"
+	"devterminal/pkg/service"
+
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// policyMsg tüm projeler doctor.policy kurallarına göre değerlendirildiğinde gönderilir
+type policyMsg struct {
+	report *service.PolicyReport
+	err    error
+}
+
+// policyFilters f tuşuyla sırayla geçilen kural türleri ("" = hepsi)
+var policyFilters = []domain.PolicyRule{"", domain.PolicyBanned, domain.PolicyMinimum, domain.PolicyLicense}
+
+// openPolicy taranan tüm projeleri bağımlılık politikasına göre değerlendirir
+func (m *MainModel) openPolicy() tea.Cmd {
+	m.State = StateDependencyPolicy
+	m.PolicyReport = nil
+	m.PolicyErr = nil
+	m.PolicyLoading = true
+	m.PolicyTable.SetRows([]table.Row{})
+	projects := m.Projects
+	return tea.Batch(m.Spinner.Tick, func() tea.Msg {
+		report, err := m.Doctor.CheckPolicy(projects)
+		return policyMsg{report: report, err: err}
+	})
+}
+
+// refreshPolicyRows filtreye uyan ihlalleri tabloya yükler
+func (m *MainModel) refreshPolicyRows() {
+	m.PolicyRows = nil
+	if m.PolicyReport == nil {
+		m.PolicyTable.SetRows([]table.Row{})
+		return
+	}
+	rows := make([]table.Row, 0, len(m.PolicyReport.Violations))
+	for _, v := range m.PolicyReport.Violations {
+		if m.PolicyFilter != "" && v.Rule != m.PolicyFilter {
+			continue
+		}
+		m.PolicyRows = append(m.PolicyRows, v)
+		name := v.Name
+		if v.Dev {
+			name += " (dev)"
+		}
+		rows = append(rows, table.Row{
+			v.Project, ecosystemIcon(v.Ecosystem) + " " + v.Ecosystem, name, orDash(v.Version), policyRuleLabel(v.Rule), v.Detail,
+		})
+	}
+	m.PolicyTable.SetRows(rows)
+	m.PolicyTable.SetCursor(0)
+	m.resizePolicyTable()
+}
+
+// resizePolicyTable tabloyu ekran yüksekliğine sığdırır
+func (m *MainModel) resizePolicyTable() {
+	h := m.Height - 14
+	if n := len(m.PolicyTable.Rows()) + 1; n < h {
+		h = n
+	}
+	if h < 3 {
+		h = 3
+	}
+	m.PolicyTable.SetHeight(h)
+}
+
+func (m *MainModel) updatePolicy(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.State = StateProjectSelect
+		return m, nil
+	case "q":
+		return m, tea.Quit
+	case "r":
+		if !m.PolicyLoading {
+			return m, m.openPolicy()
+		}
+		return m, nil
+	case "f":
+		// Tümü -> yasaklı -> alt sürüm -> lisans
+		for i, f := range policyFilters {
+			if f == m.PolicyFilter {
+				m.PolicyFilter = policyFilters[(i+1)%len(policyFilters)]
+				break
+			}
+		}
+		m.refreshPolicyRows()
+		return m, nil
+	}
+	var cmd tea.Cmd
+	m.PolicyTable, cmd = m.PolicyTable.Update(msg)
+	return m, cmd
+}
+
+func newPolicyTable() table.Model {
+	t := newTable()
+	t.SetColumns([]table.Column{
+		{Title: "Proje", Width: 18},
+		{Title: "Ekosistem", Width: 10},
+		{Title: "Paket", Width: 28},
+		{Title: "Sürüm", Width: 12},
+		{Title: "Kural", Width: 14},
+		{Title: "Detay", Width: 30},
+	})
+	return t
+}
+
+// policyRuleLabel kural türünün tablodaki karşılığıdır
+func policyRuleLabel(rule domain.PolicyRule) string {
+	switch rule {
+	case domain.PolicyBanned:
+		return "🟥 yasaklı"
+	case domain.PolicyMinimum:
+		return "🟨 alt sürüm"
+	case domain.PolicyLicense:
+		return "🟪 lisans"
+	}
+	return string(rule)
+}
+
+func (m *MainModel) policyView() string {
+	var b strings.Builder
+	greyStyle := lipgloss.NewStyle().Foreground(ColorGrey)
+
+	b.WriteString("\n" + HeaderStyle.Render(fmt.Sprintf("📏 Bağımlılık Politikası (%d proje)", len(m.Projects))) + "\n")
+
+	switch {
+	case m.PolicyLoading:
+		b.WriteString("\n" + m.Spinner.View() + " Manifestler, kilit dosyaları ve lisanslar kontrol ediliyor...\n")
+	case m.PolicyErr != nil:
+		b.WriteString("\n" + lipgloss.NewStyle().Foreground(ColorYellow).Render("⚠️  "+m.PolicyErr.Error()) + "\n")
+		b.WriteString(greyStyle.Render("Örnek (config.yaml):\n  doctor:\n    policy:\n      banned: [moment, request]\n      minimum: [\"next>=14\"]\n      licenses: [MIT, Apache-2.0, ISC, BSD-2-Clause, BSD-3-Clause]") + "\n")
+	case len(m.PolicyReport.Violations) == 0:
+		b.WriteString("\n" + lipgloss.NewStyle().Foreground(ColorGreen).Render("✅ Tüm projeler bağımlılık politikasına uyuyor!") + "\n")
+	default:
+		r := m.PolicyReport
+		counts := make(map[domain.PolicyRule]int)
+		projects := make(map[string]bool)
+		for _, v := range r.Violations {
+			counts[v.Rule]++
+			projects[v.Project] = true
+		}
+		summary := fmt.Sprintf("%d ihlal (%d projede) · %d yasaklı, %d alt sürüm, %d lisans",
+			len(r.Violations), len(projects), counts[domain.PolicyBanned], counts[domain.PolicyMinimum], counts[domain.PolicyLicense])
+		if m.PolicyFilter != "" {
+			summary += " · Filtre: " + strings.SplitN(policyRuleLabel(m.PolicyFilter), " ", 2)[1]
+		}
+		b.WriteString(greyStyle.Render(summary) + "\n")
+		if len(m.PolicyRows) == 0 {
+			b.WriteString("\n" + lipgloss.NewStyle().Foreground(ColorYellow).Render("Filtreye uyan ihlal yok") + "\n")
+		} else {
+			b.WriteString(m.PolicyTable.View() + "\n")
+		}
+	}
+
+	if r := m.PolicyReport; r != nil {
+		if r.UnknownLicenses > 0 {
+			b.WriteString(greyStyle.Render(fmt.Sprintf("%d paketin lisansı okunamadı (node_modules kurulu değil veya Go modülü önbellekte yok)", r.UnknownLicenses)) + "\n")
+		}
+		for _, e := range r.Errors {
+			b.WriteString(lipgloss.NewStyle().Foreground(ColorYellow).Render("⚠️  "+e) + "\n")
+		}
+	}
+
+	content := lipgloss.NewStyle().PaddingLeft(2).Render(b.String())
+	return content + "\n  " + m.renderFooter("↑↓", "Gezin", "f", "Filtre", "r", "Yeniden Kontrol", "Esc", "Geri Dön")
+}
@@ -0,0 +1,116 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"devterminal/pkg/service"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// launchErrorMsg terminal/şablon ile başlatma bittiğinde (hata olsun ya da olmasın) gönderilir
+type launchErrorMsg struct {
+	err error
+}
+
+// openPreview seçili projenin başlatma komutlarını çalıştırmadan gösterir
+func (m *MainModel) openPreview(mode string) {
+	m.State = StateLaunchPreview
+	m.PreviewMode = mode
+	m.Preview = m.Launcher.PreviewLaunch(m.Selected, mode)
+}
+
+// defaultPreviewMode projede bulunan parçalara göre önizlenecek modu seçer
+func (m *MainModel) defaultPreviewMode() string {
+	switch {
+	case m.Selected.FrontendPath != "" && m.Selected.BackendPath != "":
+		return "full"
+	case m.Selected.BackendPath != "":
+		return "backend"
+	}
+	return "frontend"
+}
+
+func (m *MainModel) updatePreview(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.State = StateProjectActions
+		return m, nil
+	case "q":
+		return m, tea.Quit
+	case "1", "f":
+		m.openPreview("frontend")
+	case "2", "b":
+		m.openPreview("backend")
+	case "3", "l":
+		m.openPreview("full")
+	case "enter":
+		// Önizleme temizse gerçekten başlat (dry-run modunda hiçbir şey çalışmaz)
+		if m.DryRun || m.Preview.Err != nil {
+			return m, nil
+		}
+		m.State = StateProjectActions
+		m.updateLastOpened(m.Selected.Path)
+		return m, m.launchCmd(m.PreviewMode)
+	}
+	return m, nil
+}
+
+func (m *MainModel) previewView() string {
+	var b strings.Builder
+	pv := m.Preview
+	greyStyle := lipgloss.NewStyle().Foreground(ColorGrey)
+	labelStyle := lipgloss.NewStyle().Foreground(ColorCyan)
+
+	title := "🔍 KOMUT ÖNİZLEME — " + m.Selected.Name + " › " + pv.Mode
+	if m.DryRun {
+		title += " (dry-run)"
+	}
+	b.WriteString("\n" + HeaderStyle.Render(title) + "\n")
+	b.WriteString(greyStyle.Render(strings.Repeat("─", 40)) + "\n")
+
+	b.WriteString(labelStyle.Render("Kaynak: ") + ValueStyle.Render(pv.Source) + "\n")
+	if pv.Template != "" {
+		b.WriteString(labelStyle.Render("Şablon: ") + greyStyle.Render(pv.Template) + "\n")
+	}
+	if d := pv.Data; d != nil {
+		port := "-"
+		if d.Port > 0 {
+			port = fmt.Sprint(d.Port)
+		}
+		b.WriteString(labelStyle.Render("Bağlam: ") + greyStyle.Render(fmt.Sprintf(".Port=%s  .PackageManager=%s  .OS=%s  .ConfigDir=%s", port, d.PackageManager, d.OS, d.ConfigDir)) + "\n")
+	}
+	b.WriteString("\n")
+
+	if pv.Err != nil {
+		b.WriteString(lipgloss.NewStyle().Foreground(ColorRed).Bold(true).Render("❌ Şablon hatası") + "\n")
+		b.WriteString(lipgloss.NewStyle().Foreground(ColorRed).Render("   "+pv.Err.Error()) + "\n")
+		if te, ok := pv.Err.(*service.TemplateError); ok && te.Field != "" {
+			b.WriteString(greyStyle.Render("   Sorunlu alan: ") + ValueStyle.Render(te.Field) + "\n")
+		}
+		b.WriteString("\n")
+	}
+
+	for _, c := range pv.Commands {
+		b.WriteString(lipgloss.NewStyle().Foreground(ColorPurple).Bold(true).Render("▶ "+c.Title) + greyStyle.Render("  📂 "+c.Dir) + "\n")
+		b.WriteString("  " + ValueStyle.Render(c.Line) + "\n")
+		for i, arg := range c.Argv {
+			b.WriteString(greyStyle.Render(fmt.Sprintf("    argv[%d] ", i)) + fmt.Sprintf("%q", arg) + "\n")
+		}
+		b.WriteString("\n")
+	}
+
+	for _, w := range pv.Warnings {
+		b.WriteString(lipgloss.NewStyle().Foreground(ColorYellow).Render("⚠️  "+w) + "\n")
+	}
+
+	content := lipgloss.NewStyle().PaddingLeft(2).Render(b.String())
+	pairs := []string{"1/2/3", "Frontend/Backend/Full"}
+	if !m.DryRun && pv.Err == nil {
+		pairs = append(pairs, "Enter", "Başlat")
+	}
+	pairs = append(pairs, "Esc", "Geri")
+	return content + "\n  " + m.renderFooter(pairs...)
+}
@@ -0,0 +1,361 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"devterminal/pkg/domain"
+	"devterminal/pkg/service"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+type processTickMsg time.Time
+
+// processStartedMsg gömülü başlatma tamamlandığında gönderilir
+type processStartedMsg struct {
+	err error
+}
+
+// processActionMsg durdur/yeniden başlat gibi işlemler bittiğinde gönderilir
+type processActionMsg struct {
+	err error
+}
+
+func newLogViewport() viewport.Model {
+	vp := viewport.New(0, 0)
+	// Ok tuşları süreç seçimi için kullanılıyor, kaydırma j/k ve sayfa tuşlarıyla
+	vp.KeyMap.Up = key.NewBinding(key.WithKeys("k"))
+	vp.KeyMap.Down = key.NewBinding(key.WithKeys("j"))
+	vp.KeyMap.PageUp = key.NewBinding(key.WithKeys("pgup"))
+	vp.KeyMap.PageDown = key.NewBinding(key.WithKeys("pgdown"))
+	vp.KeyMap.HalfPageUp = key.NewBinding(key.WithKeys("u"))
+	vp.KeyMap.HalfPageDown = key.NewBinding(key.WithKeys("d"))
+	return vp
+}
+
+func processTick() tea.Cmd {
+	return tea.Tick(500*time.Millisecond, func(t time.Time) tea.Msg {
+		return processTickMsg(t)
+	})
+}
+
+// openProcesses süreç ekranına geçer ve periyodik yenilemeyi başlatır
+func (m *MainModel) openProcesses() tea.Cmd {
+	m.State = StateProcesses
+	m.LogFollow = true
+	m.resizeLogViewport()
+	m.refreshLogViewport()
+	return m.ensureProcessTick()
+}
+
+// ensureProcessTick yenileme döngüsü çalışmıyorsa başlatır (çift döngüyü engeller)
+func (m *MainModel) ensureProcessTick() tea.Cmd {
+	if m.processTicking {
+		return nil
+	}
+	m.processTicking = true
+	return processTick()
+}
+
+// wantsProcessTick mevcut ekran süreç durumunu canlı gösteriyor mu
+func (m *MainModel) wantsProcessTick() bool {
+	switch m.State {
+	case StateProcesses:
+		return true
+	case StateScriptOutput:
+		return m.ScriptRun != nil && m.ScriptRun.Running()
+	case StatePipeline:
+		return m.PipelineRun != nil && m.PipelineRun.Running
+	case StateHistory:
+		return m.historyRunning()
+	case StateProjectActions:
+		if m.launchRunning {
+			return true
+		}
+		return m.Selected != nil && len(m.Launcher.Supervisor.ProjectProcesses(m.Selected.Path)) > 0
+	}
+	return false
+}
+
+// projectProcessesSection proje menüsünde projenin gömülü süreçlerini özetler
+func (m *MainModel) projectProcessesSection() string {
+	procs := m.Launcher.Supervisor.ProjectProcesses(m.Selected.Path)
+	if len(procs) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString(HeaderStyle.Render("📟 GÖMÜLÜ SÜREÇLER") + "\n")
+	b.WriteString(lipgloss.NewStyle().Foreground(ColorGrey).Render("───────────────────────") + "\n")
+	for _, mp := range procs {
+		info := mp.Info()
+		line := fmt.Sprintf("%s  %s", lipgloss.NewStyle().Width(28).Render(info.Spec.Name), processStatusLabel(info))
+		var extra []string
+		if info.Restarts > 0 {
+			extra = append(extra, fmt.Sprintf("↻ %d yeniden başlatma", info.Restarts))
+		}
+		if info.LastExitReason != "" {
+			extra = append(extra, "son çıkış: "+info.LastExitReason)
+		}
+		if info.Watching {
+			extra = append(extra, fmt.Sprintf("👁 izleniyor (%d değişiklik)", info.Reloads))
+		}
+		if len(extra) > 0 {
+			line += lipgloss.NewStyle().Foreground(ColorGrey).Render("  · " + strings.Join(extra, " · "))
+		}
+		b.WriteString(line + "\n")
+	}
+	b.WriteString("\n")
+	return b.String()
+}
+
+// launchCmd projeyi seçilen hedefe (terminal veya gömülü supervisor) göre başlatır
+func (m *MainModel) launchCmd(mode string) tea.Cmd {
+	p := m.Selected
+	// --dry-run: hiçbir şey çalıştırma, sadece komutları göster
+	if m.DryRun {
+		m.openPreview(mode)
+		return nil
+	}
+	m.LaunchErr, m.LaunchNotice = nil, ""
+	// Probe tanımlıysa backend hazır olmadan frontend başlatılmaz
+	if m.Launcher.NeedsOrderedStart(p, mode) {
+		return m.startOrderedLaunch(p, m.Launcher.ProjectPlan(p, mode))
+	}
+	if m.Config.EmbeddedLaunch {
+		return func() tea.Msg {
+			_, err := m.Launcher.StartSupervised(p, mode)
+			return processStartedMsg{err: err}
+		}
+	}
+	return func() tea.Msg { return launchErrorMsg{err: m.Launcher.LaunchProject(p, mode)} }
+}
+
+// launchToolCmd araç kaydındaki aracı terminalde açar (hata proje menüsünde gösterilir)
+func (m *MainModel) launchToolCmd(t domain.ProjectTool) tea.Cmd {
+	p := m.Selected
+	m.LaunchErr = nil
+	return func() tea.Msg { return launchErrorMsg{err: m.Launcher.LaunchTool(p, t)} }
+}
+
+// selectedProcess imlecin üzerindeki süreci döndürür
+func (m *MainModel) selectedProcess() *service.ManagedProcess {
+	procs := m.Launcher.Supervisor.Processes()
+	if len(procs) == 0 {
+		return nil
+	}
+	if m.ProcessCursor >= len(procs) {
+		m.ProcessCursor = len(procs) - 1
+	}
+	if m.ProcessCursor < 0 {
+		m.ProcessCursor = 0
+	}
+	return procs[m.ProcessCursor]
+}
+
+func (m *MainModel) updateProcesses(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	procs := m.Launcher.Supervisor.Processes()
+
+	switch msg.String() {
+	case "esc":
+		m.State = StateProjectActions
+		if m.Selected == nil {
+			m.State = StateProjectSelect
+		}
+		return m, nil
+	case "q":
+		return m, tea.Quit
+	case "up":
+		if m.ProcessCursor > 0 {
+			m.ProcessCursor--
+			m.LogFollow = true
+			m.refreshLogViewport()
+		}
+		return m, nil
+	case "down":
+		if m.ProcessCursor < len(procs)-1 {
+			m.ProcessCursor++
+			m.LogFollow = true
+			m.refreshLogViewport()
+		}
+		return m, nil
+	case "s":
+		if mp := m.selectedProcess(); mp != nil {
+			id := mp.ID
+			return m, func() tea.Msg { return processActionMsg{err: m.Launcher.Supervisor.Stop(id)} }
+		}
+		return m, nil
+	case "r":
+		if mp := m.selectedProcess(); mp != nil {
+			id := mp.ID
+			return m, func() tea.Msg { return processActionMsg{err: m.Launcher.Supervisor.Restart(id)} }
+		}
+		return m, nil
+	case "w":
+		// Hot reload'u olmayan süreçler için dosya değişince yeniden başlat
+		if mp := m.selectedProcess(); mp != nil {
+			if mp.Info().Watching {
+				m.Launcher.Supervisor.Unwatch(mp.ID)
+				m.refreshLogViewport()
+				return m, nil
+			}
+			id := mp.ID
+			return m, func() tea.Msg { return processActionMsg{err: m.Launcher.WatchProcess(id)} }
+		}
+		return m, nil
+	case "x":
+		if mp := m.selectedProcess(); mp != nil {
+			m.Launcher.Supervisor.Remove(mp.ID)
+			m.refreshLogViewport()
+		}
+		return m, nil
+	case "G", "end":
+		m.LogFollow = true
+		m.LogViewport.GotoBottom()
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.LogViewport, cmd = m.LogViewport.Update(msg)
+	m.LogFollow = m.LogViewport.AtBottom()
+	return m, cmd
+}
+
+// processListHeight süreç listesinin ekranda kaplayacağı satır sayısı
+func (m *MainModel) processListHeight() int {
+	n := len(m.Launcher.Supervisor.Processes())
+	if n == 0 {
+		n = 1
+	}
+	if n > 8 {
+		n = 8
+	}
+	return n
+}
+
+func (m *MainModel) resizeLogViewport() {
+	m.LogViewport.Width = m.Width - 4
+	h := m.Height - m.processListHeight() - 9
+	if m.launchRunning {
+		h -= len(m.LaunchSteps) + 3
+	}
+	if h < 3 {
+		h = 3
+	}
+	m.LogViewport.Height = h
+}
+
+// refreshLogViewport seçili sürecin loglarını viewport'a yükler
+func (m *MainModel) refreshLogViewport() {
+	mp := m.selectedProcess()
+	if mp == nil {
+		m.LogViewport.SetContent("")
+		return
+	}
+	m.LogViewport.SetContent(strings.Join(mp.Logs.Lines(), "\n"))
+	if m.LogFollow {
+		m.LogViewport.GotoBottom()
+	}
+}
+
+// formatUptime süreyi kısa biçimde yazar (1h02m, 3m12s, 8s)
+func formatUptime(d time.Duration) string {
+	d = d.Round(time.Second)
+	h := int(d.Hours())
+	mi := int(d.Minutes()) % 60
+	s := int(d.Seconds()) % 60
+	if h > 0 {
+		return fmt.Sprintf("%dh%02dm", h, mi)
+	}
+	if mi > 0 {
+		return fmt.Sprintf("%dm%02ds", mi, s)
+	}
+	return fmt.Sprintf("%ds", s)
+}
+
+// processStatusLabel durum için ikon ve renkli etiket döndürür
+func processStatusLabel(info service.ProcessInfo) string {
+	switch info.Status {
+	case service.ProcessRunning:
+		return lipgloss.NewStyle().Foreground(ColorGreen).Render("● Çalışıyor")
+	case service.ProcessStopped:
+		return lipgloss.NewStyle().Foreground(ColorYellow).Render("■ Durduruldu")
+	case service.ProcessFailed:
+		return lipgloss.NewStyle().Foreground(ColorRed).Render(fmt.Sprintf("✖ Hata (exit %d)", info.ExitCode))
+	case service.ProcessBackoff:
+		wait := time.Until(info.NextRestart)
+		if wait < 0 {
+			wait = 0
+		}
+		return lipgloss.NewStyle().Foreground(ColorYellow).Render(fmt.Sprintf("⏳ Yeniden başlatılacak (%s)", formatUptime(wait)))
+	default:
+		return lipgloss.NewStyle().Foreground(ColorCyan).Render(fmt.Sprintf("✔ Bitti (exit %d)", info.ExitCode))
+	}
+}
+
+func (m *MainModel) processesView() string {
+	var b strings.Builder
+	b.WriteString("\n" + HeaderStyle.Render("📟 SÜREÇLER") + "\n")
+	b.WriteString(lipgloss.NewStyle().Foreground(ColorGrey).Render(strings.Repeat("─", 40)) + "\n")
+
+	procs := m.Launcher.Supervisor.Processes()
+	if len(procs) == 0 {
+		b.WriteString(lipgloss.NewStyle().Foreground(ColorGrey).Render("Henüz gömülü süreç yok. Proje menüsünde [G] ile gömülü moda geçip başlatın.") + "\n")
+	}
+
+	// Seçili süreci görünür tutacak şekilde en fazla 8 satır göster
+	first := 0
+	if m.ProcessCursor >= 8 {
+		first = m.ProcessCursor - 7
+	}
+	for i := first; i < len(procs) && i < first+8; i++ {
+		info := procs[i].Info()
+		cursor := "  "
+		nameStyle := lipgloss.NewStyle().Foreground(ColorWhite)
+		if i == m.ProcessCursor {
+			cursor = lipgloss.NewStyle().Foreground(ColorPurple).Render("▸ ")
+			nameStyle = nameStyle.Foreground(ColorPurple).Bold(true)
+		}
+		details := fmt.Sprintf("PID %d  ⏱ %s", info.PID, formatUptime(info.Uptime()))
+		if info.Restarts > 0 {
+			details += fmt.Sprintf("  ↻ %d", info.Restarts)
+		}
+		if info.Watching {
+			details += fmt.Sprintf("  👁 %d", info.Reloads)
+		}
+		line := fmt.Sprintf("%s%s %s  %s",
+			cursor,
+			nameStyle.Width(32).Render(info.Spec.Name),
+			lipgloss.NewStyle().Foreground(ColorGrey).Render(details),
+			processStatusLabel(info),
+		)
+		b.WriteString(line + "\n")
+	}
+
+	if m.ProcessErr != nil {
+		b.WriteString(lipgloss.NewStyle().Foreground(ColorRed).Render("⚠️  "+m.ProcessErr.Error()) + "\n")
+	}
+	if m.launchRunning {
+		b.WriteString("\n" + m.launchProgressSection())
+	}
+
+	// Log paneli
+	title := "📜 Loglar"
+	if mp := m.selectedProcess(); mp != nil {
+		title += " — " + mp.Spec.Command
+	}
+	b.WriteString("\n" + lipgloss.NewStyle().Foreground(ColorCyan).Render(title) + "\n")
+	b.WriteString(lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(ColorGrey).
+		Render(m.LogViewport.View()) + "\n")
+
+	content := lipgloss.NewStyle().PaddingLeft(2).Render(b.String())
+	footer := m.renderFooter("↑↓", "Seç", "j/k", "Kaydır", "s", "Durdur", "r", "Yeniden Başlat", "w", "İzle", "x", "Kaldır", "Esc", "Geri")
+	return content + "\n  " + footer
+}
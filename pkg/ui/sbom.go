@@ -0,0 +1,148 @@
+package ui
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"devterminal/pkg/config"
+	"devterminal/pkg/service"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// sbomMsg kilit dosyaları okunup SBOM hazırlandığında gönderilir
+type sbomMsg struct {
+	sbom *service.SBOM
+	err  error
+}
+
+// openSBOM seçili projenin kilit dosyalarından SBOM'u hazırlar
+func (m *MainModel) openSBOM() tea.Cmd {
+	m.State = StateSBOM
+	m.SBOM = nil
+	m.SBOMErr = nil
+	m.SBOMStatus = ""
+	m.SBOMLoading = true
+	p := m.Selected
+	return tea.Batch(m.Spinner.Tick, func() tea.Msg {
+		sbom, err := m.Doctor.BuildSBOM(p)
+		return sbomMsg{sbom: sbom, err: err}
+	})
+}
+
+// exportSBOM SBOM'u yapılandırma klasörüne verilen biçimde kaydeder
+func (m *MainModel) exportSBOM(format service.SBOMFormat) {
+	dir, err := config.ConfigDir()
+	if err != nil {
+		m.SBOMStatus = "❌ " + err.Error()
+		return
+	}
+	path, err := service.ExportSBOM(m.SBOM, format, filepath.Join(dir, "exports"))
+	if err != nil {
+		m.SBOMStatus = "❌ Dışa aktarılamadı: " + err.Error()
+		return
+	}
+	m.SBOMStatus = "💾 Kaydedildi: " + path
+}
+
+func (m *MainModel) updateSBOM(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.State = StateProjectActions
+	case "q":
+		return m, tea.Quit
+	case "r":
+		if !m.SBOMLoading {
+			return m, m.openSBOM()
+		}
+	case "c":
+		if m.SBOM != nil {
+			m.exportSBOM(service.SBOMCycloneDX)
+		}
+	case "s":
+		if m.SBOM != nil {
+			m.exportSBOM(service.SBOMSPDX)
+		}
+	}
+	return m, nil
+}
+
+func (m *MainModel) sbomView() string {
+	var b strings.Builder
+	greyStyle := lipgloss.NewStyle().Foreground(ColorGrey)
+	labelStyle := lipgloss.NewStyle().Foreground(ColorCyan)
+
+	b.WriteString("\n" + HeaderStyle.Render("📦 "+m.Selected.Name+" İçin SBOM (CycloneDX 1.5 / SPDX 2.3)") + "\n")
+
+	switch {
+	case m.SBOMLoading:
+		b.WriteString("\n" + m.Spinner.View() + " Kilit dosyaları ve lisanslar okunuyor...\n")
+		return lipgloss.NewStyle().PaddingLeft(2).Render(b.String()) + "\n  " + m.renderFooter("Esc", "Geri Dön")
+	case m.SBOMErr != nil:
+		b.WriteString("\n" + lipgloss.NewStyle().Foreground(ColorRed).Render("⚠️  Hata: "+m.SBOMErr.Error()) + "\n")
+		return lipgloss.NewStyle().PaddingLeft(2).Render(b.String()) + "\n  " + m.renderFooter("r", "Yeniden Dene", "Esc", "Geri Dön")
+	}
+
+	s := m.SBOM
+	ecosystems := make(map[string]int)
+	licenses := make(map[string]int)
+	for _, c := range s.Components {
+		ecosystems[c.Ecosystem]++
+		if c.License != "" {
+			licenses[c.License]++
+		}
+	}
+
+	b.WriteString("\n" + labelStyle.Render("Bileşenler: ") + ValueStyle.Render(fmt.Sprint(len(s.Components))))
+	var parts []string
+	// purl türü -> doktor tablosundaki ekosistem adı (ikon için)
+	for _, eco := range []struct{ purl, name string }{{"npm", "npm"}, {"golang", "go"}} {
+		if ecosystems[eco.purl] > 0 {
+			parts = append(parts, fmt.Sprintf("%s %s: %d", ecosystemIcon(eco.name), eco.name, ecosystems[eco.purl]))
+		}
+	}
+	b.WriteString(greyStyle.Render("  ("+strings.Join(parts, " · ")+")") + "\n")
+	if len(s.Components) > 0 {
+		b.WriteString(labelStyle.Render("Lisans okunan: ") + ValueStyle.Render(fmt.Sprintf("%d / %d (%%%d)", s.Licensed(), len(s.Components), s.Licensed()*100/len(s.Components))) + "\n")
+	}
+	b.WriteString(labelStyle.Render("Kaynaklar: ") + ValueStyle.Render(strings.Join(s.Sources, ", ")) + "\n")
+
+	// En çok kullanılan lisanslar
+	if len(licenses) > 0 {
+		names := make([]string, 0, len(licenses))
+		for l := range licenses {
+			names = append(names, l)
+		}
+		sort.Slice(names, func(i, j int) bool {
+			if licenses[names[i]] != licenses[names[j]] {
+				return licenses[names[i]] > licenses[names[j]]
+			}
+			return names[i] < names[j]
+		})
+		b.WriteString("\n" + labelStyle.Render("Lisanslar:") + "\n")
+		for i, l := range names {
+			if i == 10 {
+				b.WriteString(greyStyle.Render(fmt.Sprintf("  ... %d lisans daha", len(names)-10)) + "\n")
+				break
+			}
+			b.WriteString(fmt.Sprintf("  %-32s %s\n", l, greyStyle.Render(fmt.Sprint(licenses[l]))))
+		}
+	}
+	if s.Licensed() < len(s.Components) {
+		b.WriteString(greyStyle.Render("  Lisansı okunamayan paketler NOASSERTION olarak yazılır (node_modules kurulu değil veya Go modülü önbellekte yok)") + "\n")
+	}
+
+	b.WriteString("\n")
+	for _, e := range s.Errors {
+		b.WriteString(lipgloss.NewStyle().Foreground(ColorYellow).Render("⚠️  "+e) + "\n")
+	}
+	if m.SBOMStatus != "" {
+		b.WriteString(greyStyle.Render(m.SBOMStatus) + "\n")
+	}
+
+	content := lipgloss.NewStyle().PaddingLeft(2).Render(b.String())
+	return content + "\n  " + m.renderFooter("c", "CycloneDX Kaydet", "s", "SPDX Kaydet", "r", "Yenile", "Esc", "Geri Dön")
+}
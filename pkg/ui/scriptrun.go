@@ -0,0 +1,285 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"devterminal/pkg/domain"
+	"devterminal/pkg/service"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// scriptDoneMsg satır içi çalıştırılan script sonlandığında gönderilir
+type scriptDoneMsg struct {
+	key string
+	mp  *service.ManagedProcess
+}
+
+// scriptResult bir scriptin son çalıştırma sonucudur (listede scriptin yanında gösterilir)
+type scriptResult struct {
+	Status   service.ProcessStatus
+	ExitCode int
+	Duration time.Duration
+}
+
+// label sonucu kısa, ikonlu metne çevirir
+func (r scriptResult) label() string {
+	switch r.Status {
+	case service.ProcessStopped:
+		return fmt.Sprintf("■ İptal edildi · %s", formatUptime(r.Duration))
+	case service.ProcessExited:
+		return fmt.Sprintf("✅ exit 0 · %s", formatUptime(r.Duration))
+	default:
+		return fmt.Sprintf("❌ exit %d · %s", r.ExitCode, formatUptime(r.Duration))
+	}
+}
+
+// scriptKey sonuçları proje, görev kaynağı ve adına göre ayırır
+func scriptKey(projectPath string, t domain.Task) string {
+	return projectPath + "\x00" + t.Source + "\x00" + t.Name
+}
+
+// scriptWatchMsg izlenen klasörde değişiklik olduğunda gönderilir
+type scriptWatchMsg struct {
+	files []string
+	ch    <-chan []string
+}
+
+// scriptRerunMsg izleme modunda eski çalıştırma durdurulduktan sonra gönderilir
+type scriptRerunMsg struct{}
+
+func waitScriptDone(key string, mp *service.ManagedProcess) tea.Cmd {
+	return func() tea.Msg {
+		<-mp.Done()
+		return scriptDoneMsg{key: key, mp: mp}
+	}
+}
+
+// runTaskInline görevi Task Runner içinde çalıştırır ve çıktı paneline geçer
+func (m *MainModel) runTaskInline(t domain.Task) tea.Cmd {
+	if m.ScriptRun != nil && m.ScriptRun.Running() {
+		// Aynı anda tek satır içi script
+		m.State = StateScriptOutput
+		return nil
+	}
+
+	key := scriptKey(m.Selected.Path, t)
+	if key != m.ScriptRunKey {
+		// Başka bir görev: önceki görevin izleme modu kapanır
+		m.stopScriptWatch()
+	}
+	m.State = StateScriptOutput
+	return m.startTaskInline(t)
+}
+
+// startTaskInline görevi satır içi başlatır (ekran değiştirmeden; izleme modu da kullanır)
+func (m *MainModel) startTaskInline(t domain.Task) tea.Cmd {
+	key := scriptKey(m.Selected.Path, t)
+	mp, err := m.Launcher.RunTaskInline(*m.Selected, t)
+	m.ScriptRun = mp
+	m.ScriptRunKey = key
+	m.ScriptTask = t
+	m.ScriptFollow = true
+	m.ScriptViewport = viewport.New(0, 0)
+	m.refreshScriptViewport()
+
+	if err != nil {
+		// Başlatılamadı: sonucu hemen kaydet
+		m.recordScriptResult(key, mp)
+		return nil
+	}
+	return tea.Batch(waitScriptDone(key, mp), m.ensureProcessTick())
+}
+
+// recordScriptResult sonlanan scriptin sonucunu saklar ve listeyi günceller
+func (m *MainModel) recordScriptResult(key string, mp *service.ManagedProcess) {
+	info := mp.Info()
+	if m.ScriptResults == nil {
+		m.ScriptResults = make(map[string]scriptResult)
+	}
+	m.ScriptResults[key] = scriptResult{Status: info.Status, ExitCode: info.ExitCode, Duration: info.Uptime()}
+	m.refreshScriptItems()
+}
+
+// refreshScriptItems listedeki script ve pipeline'ların yanındaki son sonuçları günceller
+func (m *MainModel) refreshScriptItems() {
+	if m.Selected == nil {
+		return
+	}
+	for i, it := range m.TaskRunnerList.Items() {
+		switch si := it.(type) {
+		case taskItem:
+			if r, ok := m.ScriptResults[scriptKey(m.Selected.Path, si.task)]; ok {
+				si.result = &r
+				m.TaskRunnerList.SetItem(i, si)
+			}
+		case pipelineItem:
+			if r, ok := m.ScriptResults[pipelineKey(m.Selected.Path, si.pipeline.Name)]; ok {
+				si.result = &r
+				m.TaskRunnerList.SetItem(i, si)
+			}
+		}
+	}
+}
+
+// refreshScriptViewport çıktı panelini boyutlandırır ve logları yükler
+func (m *MainModel) refreshScriptViewport() {
+	m.ScriptViewport.Width = m.Width - 4
+	h := m.Height - 9
+	if h < 3 {
+		h = 3
+	}
+	m.ScriptViewport.Height = h
+
+	if m.ScriptRun == nil {
+		return
+	}
+	m.ScriptViewport.SetContent(strings.Join(m.ScriptRun.Logs.Lines(), "\n"))
+	if m.ScriptFollow {
+		m.ScriptViewport.GotoBottom()
+	}
+}
+
+func (m *MainModel) updateScriptOutput(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		// Script arka planda devam eder, sonuç listede görünür
+		m.State = m.ScriptReturn
+		if m.State == StateHistory {
+			m.HistoryEntries = m.Launcher.History.Entries()
+		}
+		return m, nil
+	case "q":
+		return m, tea.Quit
+	case "r":
+		if m.ScriptRun != nil && !m.ScriptRun.Running() {
+			return m, m.runTaskInline(m.ScriptTask)
+		}
+		return m, nil
+	case "w":
+		if m.scriptWatchCancel != nil {
+			m.stopScriptWatch()
+			return m, nil
+		}
+		return m, m.startScriptWatch()
+	case "G", "end":
+		m.ScriptFollow = true
+		m.ScriptViewport.GotoBottom()
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.ScriptViewport, cmd = m.ScriptViewport.Update(msg)
+	m.ScriptFollow = m.ScriptViewport.AtBottom()
+	return m, cmd
+}
+
+// cancelScript çalışan satır içi scripti durdurur (ctrl+c)
+func (m *MainModel) cancelScript() tea.Cmd {
+	mp := m.ScriptRun
+	return func() tea.Msg {
+		_ = mp.Stop()
+		return nil
+	}
+}
+
+func waitScriptWatch(ch <-chan []string) tea.Cmd {
+	return func() tea.Msg {
+		files, ok := <-ch
+		if !ok {
+			return nil
+		}
+		return scriptWatchMsg{files: files, ch: ch}
+	}
+}
+
+// startScriptWatch görevin klasöründeki değişikliklerde görevi yeniden çalıştırır
+func (m *MainModel) startScriptWatch() tea.Cmd {
+	w := m.Launcher.NewProjectWatcher(m.Selected.Path, m.ScriptTask.Dir)
+	ctx, cancel := context.WithCancel(context.Background())
+	ch, err := w.Start(ctx)
+	if err != nil {
+		cancel()
+		m.ScriptWatchErr = err
+		return nil
+	}
+	m.scriptWatchCancel = cancel
+	m.ScriptWatchDesc = w.Describe()
+	m.ScriptWatchLast = ""
+	m.ScriptWatchErr = nil
+	return waitScriptWatch(ch)
+}
+
+// stopScriptWatch izleme modunu kapatır (açık değilse bir şey yapmaz)
+func (m *MainModel) stopScriptWatch() {
+	if m.scriptWatchCancel != nil {
+		m.scriptWatchCancel()
+		m.scriptWatchCancel = nil
+	}
+}
+
+// rerunWatchedScript çalışan görevi durdurur ve değişiklikten sonra yeniden başlatır
+func (m *MainModel) rerunWatchedScript(files []string) tea.Cmd {
+	m.ScriptWatchLast = strings.Join(files, ", ")
+	mp := m.ScriptRun
+	return func() tea.Msg {
+		if mp != nil {
+			_ = mp.Stop()
+		}
+		return scriptRerunMsg{}
+	}
+}
+
+func (m *MainModel) scriptOutputView() string {
+	var b strings.Builder
+	mp := m.ScriptRun
+	info := mp.Info()
+
+	b.WriteString("\n" + HeaderStyle.Render("📜 "+m.Selected.Name+" › "+info.Spec.Name) + "\n")
+	b.WriteString(lipgloss.NewStyle().Foreground(ColorGrey).Render("▶ "+info.Spec.Command+"  ("+info.Spec.Dir+")") + "\n")
+
+	var status string
+	switch info.Status {
+	case service.ProcessRunning:
+		status = lipgloss.NewStyle().Foreground(ColorGreen).Render("● Çalışıyor") + "  ⏱ " + formatUptime(info.Uptime())
+	case service.ProcessStopped:
+		status = lipgloss.NewStyle().Foreground(ColorYellow).Render(scriptResult{Status: info.Status, Duration: info.Uptime()}.label())
+	case service.ProcessExited:
+		status = lipgloss.NewStyle().Foreground(ColorGreen).Render(scriptResult{Status: info.Status, Duration: info.Uptime()}.label())
+	default:
+		status = lipgloss.NewStyle().Foreground(ColorRed).Render(scriptResult{Status: info.Status, ExitCode: info.ExitCode, Duration: info.Uptime()}.label())
+	}
+	if m.scriptWatchCancel != nil {
+		status += lipgloss.NewStyle().Foreground(ColorCyan).Render("   👁 İzleniyor: " + m.ScriptWatchDesc)
+	}
+	b.WriteString(status + "\n")
+	if m.scriptWatchCancel != nil && m.ScriptWatchLast != "" {
+		b.WriteString(lipgloss.NewStyle().Foreground(ColorGrey).Render("↻ Son değişiklik: "+m.ScriptWatchLast) + "\n")
+	}
+	if m.ScriptWatchErr != nil {
+		b.WriteString(lipgloss.NewStyle().Foreground(ColorRed).Render("⚠️  "+m.ScriptWatchErr.Error()) + "\n")
+	}
+
+	b.WriteString(lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(ColorGrey).
+		Render(m.ScriptViewport.View()) + "\n")
+
+	content := lipgloss.NewStyle().PaddingLeft(2).Render(b.String())
+	watchLabel := "İzle"
+	if m.scriptWatchCancel != nil {
+		watchLabel = "İzlemeyi Kapat"
+	}
+	var footer string
+	if info.Status == service.ProcessRunning {
+		footer = m.renderFooter("ctrl+c", "İptal", "w", watchLabel, "j/k", "Kaydır", "G", "Sona Git", "Esc", "Listeye Dön")
+	} else {
+		footer = m.renderFooter("r", "Tekrar Çalıştır", "w", watchLabel, "j/k", "Kaydır", "G", "Sona Git", "Esc", "Listeye Dön")
+	}
+	return content + "\n  " + footer
+}
@@ -0,0 +1,156 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"devterminal/pkg/service"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// openServices manifest servis seçim ekranını açar
+func (m *MainModel) openServices() {
+	m.State = StateServices
+	m.ServiceCursor = 0
+	m.ServiceSelected = make(map[string]bool)
+	m.ServiceErr = nil
+
+	// Port durumunu ekran açılırken bir kez kontrol et
+	m.ServicePortsInUse = make(map[int]bool)
+	for _, svc := range m.Selected.Services {
+		for _, port := range svc.Ports {
+			if service.IsPortInUse(port) {
+				m.ServicePortsInUse[port] = true
+			}
+		}
+	}
+}
+
+func (m *MainModel) updateServices(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	services := m.Selected.Services
+
+	switch msg.String() {
+	case "esc":
+		m.State = StateProjectActions
+		return m, nil
+	case "q":
+		return m, tea.Quit
+	case "up", "k":
+		if m.ServiceCursor > 0 {
+			m.ServiceCursor--
+		}
+	case "down", "j":
+		if m.ServiceCursor < len(services)-1 {
+			m.ServiceCursor++
+		}
+	case " ", "x":
+		if m.ServiceCursor < len(services) {
+			name := services[m.ServiceCursor].Name
+			m.ServiceSelected[name] = !m.ServiceSelected[name]
+		}
+	case "a":
+		// Hepsi seçiliyse temizle, değilse hepsini seç
+		all := true
+		for _, svc := range services {
+			all = all && m.ServiceSelected[svc.Name]
+		}
+		for _, svc := range services {
+			m.ServiceSelected[svc.Name] = !all
+		}
+	case "enter":
+		var names []string
+		for _, svc := range services {
+			if m.ServiceSelected[svc.Name] {
+				names = append(names, svc.Name)
+			}
+		}
+		// Seçim yoksa imlecin üzerindeki servisi başlat
+		if len(names) == 0 && m.ServiceCursor < len(services) {
+			names = []string{services[m.ServiceCursor].Name}
+		}
+		if len(names) == 0 {
+			return m, nil
+		}
+
+		plan, err := m.Launcher.ServicePlan(m.Selected, names)
+		if err != nil {
+			m.ServiceErr = err
+			return m, nil
+		}
+		m.State = StateProjectActions
+		return m, m.startOrderedLaunch(m.Selected, plan)
+	}
+	return m, nil
+}
+
+func (m *MainModel) servicesView() string {
+	var b strings.Builder
+	b.WriteString("\n" + HeaderStyle.Render("🧱 SERVİSLER ("+service.ManifestFileName+")") + "\n")
+	b.WriteString(lipgloss.NewStyle().Foreground(ColorGrey).Render(strings.Repeat("─", 40)) + "\n")
+
+	if m.Selected.ManifestError != "" {
+		b.WriteString(lipgloss.NewStyle().Foreground(ColorRed).Render("⚠️  "+m.Selected.ManifestError) + "\n")
+	}
+
+	// Çalışan servisleri işaretlemek için süreç adlarını topla
+	running := make(map[string]bool)
+	for _, mp := range m.Launcher.Supervisor.ProjectProcesses(m.Selected.Path) {
+		if mp.Running() {
+			running[mp.Spec.Name] = true
+		}
+	}
+
+	greyStyle := lipgloss.NewStyle().Foreground(ColorGrey)
+	for i, svc := range m.Selected.Services {
+		cursor := "  "
+		nameStyle := lipgloss.NewStyle().Foreground(ColorWhite)
+		if i == m.ServiceCursor {
+			cursor = lipgloss.NewStyle().Foreground(ColorPurple).Render("▸ ")
+			nameStyle = nameStyle.Foreground(ColorPurple).Bold(true)
+		}
+		check := "[ ]"
+		if m.ServiceSelected[svc.Name] {
+			check = lipgloss.NewStyle().Foreground(ColorGreen).Render("[✓]")
+		}
+
+		line := fmt.Sprintf("%s%s %s %s", cursor, check, nameStyle.Width(20).Render(svc.Name), greyStyle.Render(svc.Command))
+		if running[m.Selected.Name+" › "+svc.Name] {
+			line += lipgloss.NewStyle().Foreground(ColorGreen).Render("  ● Çalışıyor")
+		}
+		b.WriteString(line + "\n")
+
+		// Ayrıntılar: bağımlılıklar, portlar, hazır olma kontrolü
+		var details []string
+		if svc.Dir != "" {
+			details = append(details, "📁 "+svc.Dir)
+		}
+		if len(svc.DependsOn) > 0 {
+			details = append(details, "🔗 "+strings.Join(svc.DependsOn, ", "))
+		}
+		for _, port := range svc.Ports {
+			if m.ServicePortsInUse[port] {
+				details = append(details, lipgloss.NewStyle().Foreground(ColorYellow).Render(fmt.Sprintf("⚠️ :%d kullanımda", port)))
+			} else {
+				details = append(details, fmt.Sprintf("🔌 :%d", port))
+			}
+		}
+		if probe := service.DescribeProbe(svc.Ready); probe != "" {
+			details = append(details, "🚦 "+probe)
+		}
+		if len(details) > 0 {
+			b.WriteString("        " + greyStyle.Render(strings.Join(details, "  ")) + "\n")
+		}
+	}
+
+	if m.ServiceErr != nil {
+		b.WriteString("\n" + lipgloss.NewStyle().Foreground(ColorRed).Render("⚠️  "+m.ServiceErr.Error()) + "\n")
+	}
+
+	b.WriteString("\n" + greyStyle.Render("Bağımlılıklar otomatik olarak önce başlatılır ve hazır olmaları beklenir.") + "\n")
+
+	content := lipgloss.NewStyle().PaddingLeft(2).Render(b.String())
+	footer := m.renderFooter("↑↓", "Seç", "Space", "İşaretle", "a", "Tümü", "Enter", "Başlat", "Esc", "Geri")
+	return content + "\n  " + footer
+}
@@ -0,0 +1,123 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"devterminal/pkg/domain"
+	"devterminal/pkg/service"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// launchProgressMsg sıralı başlatmadan gelen tek bir ilerleme bildirimi
+type launchProgressMsg struct {
+	progress service.LaunchProgress
+	ch       <-chan service.LaunchProgress
+}
+
+// launchFinishedMsg sıralı başlatma bittiğinde (başarılı ya da değil) gönderilir
+type launchFinishedMsg struct{}
+
+// waitLaunchProgress kanaldan bir sonraki ilerleme bildirimini bekler
+func waitLaunchProgress(ch <-chan service.LaunchProgress) tea.Cmd {
+	return func() tea.Msg {
+		p, ok := <-ch
+		if !ok {
+			return launchFinishedMsg{}
+		}
+		return launchProgressMsg{progress: p, ch: ch}
+	}
+}
+
+// startOrderedLaunch planın adımlarını arka planda sırayla başlatır ve ilerlemeyi UI'a aktarır
+func (m *MainModel) startOrderedLaunch(p *domain.Project, plan service.LaunchPlan) tea.Cmd {
+	steps := plan.Steps
+	switch {
+	case m.launchRunning:
+		m.LaunchNotice = "Başka bir sıralı başlatma sürüyor; iptal için [Esc] / [Ctrl+C]"
+		return nil
+	case len(steps) == 0:
+		m.LaunchNotice = "Başlatılacak bir komut bulunamadı"
+		return nil
+	}
+	m.LaunchNotice = ""
+
+	m.LaunchSteps = make([]service.LaunchProgress, len(steps))
+	for i, s := range steps {
+		m.LaunchSteps[i] = service.LaunchProgress{Step: i, Name: s.Name, Stage: service.StagePending, Probe: service.DescribeProbe(s.Ready)}
+	}
+	m.launchProject = p.Path
+	m.launchStarted = time.Now()
+	m.launchRunning = true
+
+	ctx, cancel := context.WithCancel(context.Background())
+	m.launchCancel = cancel
+
+	ch := make(chan service.LaunchProgress)
+	embedded := m.Config.EmbeddedLaunch
+	go func() {
+		defer cancel()
+		_ = m.Launcher.RunSteps(ctx, p, plan, embedded, ch)
+	}()
+
+	cmds := []tea.Cmd{waitLaunchProgress(ch), m.ensureProcessTick()}
+	if embedded {
+		cmds = append(cmds, m.openProcesses())
+	}
+	return tea.Batch(cmds...)
+}
+
+// cancelOrderedLaunch süren sıralı başlatmayı (örn: takılan bir hazır olma beklemesini) iptal eder
+func (m *MainModel) cancelOrderedLaunch() bool {
+	if !m.launchRunning || m.launchCancel == nil {
+		return false
+	}
+	m.launchCancel()
+	return true
+}
+
+// launchProgressSection seçili projenin son sıralı başlatma durumunu gösterir
+func (m *MainModel) launchProgressSection() string {
+	var b strings.Builder
+	if m.LaunchNotice != "" {
+		b.WriteString(lipgloss.NewStyle().Foreground(ColorYellow).Render("⚠️  "+m.LaunchNotice) + "\n\n")
+	}
+	if len(m.LaunchSteps) == 0 || m.Selected == nil || m.Selected.Path != m.launchProject {
+		return b.String()
+	}
+
+	title := "🚦 SIRALI BAŞLATMA"
+	if m.launchRunning {
+		title += fmt.Sprintf(" (%s) · [Esc] İptal", formatUptime(time.Since(m.launchStarted)))
+	}
+	b.WriteString(HeaderStyle.Render(title) + "\n")
+	b.WriteString(lipgloss.NewStyle().Foreground(ColorGrey).Render("───────────────────────") + "\n")
+
+	for i, s := range m.LaunchSteps {
+		b.WriteString(fmt.Sprintf("%d. %s  %s\n", i+1, lipgloss.NewStyle().Width(28).Render(s.Name), launchStageLabel(s)))
+	}
+	b.WriteString("\n")
+	return b.String()
+}
+
+// launchStageLabel adımın durumu için ikon ve renkli etiket döndürür
+func launchStageLabel(s service.LaunchProgress) string {
+	switch s.Stage {
+	case service.StageStarting:
+		return lipgloss.NewStyle().Foreground(ColorCyan).Render("▶ Başlatılıyor...")
+	case service.StageWaiting:
+		return lipgloss.NewStyle().Foreground(ColorYellow).Render("⏳ Hazır olması bekleniyor (" + s.Probe + ")")
+	case service.StageReady:
+		return lipgloss.NewStyle().Foreground(ColorGreen).Render("✅ Hazır")
+	case service.StageSkipped:
+		return lipgloss.NewStyle().Foreground(ColorYellow).Render("⚠️  Başlatıldı, kontrol atlandı: " + s.Err.Error())
+	case service.StageFailed:
+		return lipgloss.NewStyle().Foreground(ColorRed).Render("❌ " + s.Err.Error())
+	default:
+		return lipgloss.NewStyle().Foreground(ColorGrey).Render("○ Sırada")
+	}
+}
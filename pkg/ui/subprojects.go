@@ -0,0 +1,155 @@
+package ui
+
+import (
+	"fmt"
+	"strings"
+
+	"devterminal/pkg/config"
+	"devterminal/pkg/domain"
+	"devterminal/pkg/service"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// openSubProjects monorepo alt proje seçim ekranını açar, son seçimi config'den yükler
+func (m *MainModel) openSubProjects() {
+	m.State = StateSubProjects
+	m.SubProjectErr = nil
+	m.SubProjectCursor = 0
+	m.SubProjectSelected = make(map[string]bool)
+	for _, key := range m.savedSubProjects() {
+		m.SubProjectSelected[key] = true
+	}
+}
+
+// savedSubProjects seçili projenin config'de hatırlanan alt proje seçimini döndürür
+func (m *MainModel) savedSubProjects() []string {
+	return m.Config.ProjectOverrides[strings.ToLower(m.Selected.Path)].SubProjects
+}
+
+func (m *MainModel) updateSubProjects(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	subs := service.SubProjects(m.Selected)
+
+	switch msg.String() {
+	case "esc":
+		m.State = StateProjectActions
+		return m, nil
+	case "q":
+		return m, tea.Quit
+	case "up", "k":
+		if m.SubProjectCursor > 0 {
+			m.SubProjectCursor--
+		}
+	case "down", "j":
+		if m.SubProjectCursor < len(subs)-1 {
+			m.SubProjectCursor++
+		}
+	case " ", "x":
+		if m.SubProjectCursor < len(subs) {
+			key := service.SubProjectKey(m.Selected, subs[m.SubProjectCursor])
+			m.SubProjectSelected[key] = !m.SubProjectSelected[key]
+		}
+	case "a":
+		// Hepsi seçiliyse temizle, değilse hepsini seç
+		all := true
+		for _, sp := range subs {
+			all = all && m.SubProjectSelected[service.SubProjectKey(m.Selected, sp)]
+		}
+		for _, sp := range subs {
+			m.SubProjectSelected[service.SubProjectKey(m.Selected, sp)] = !all
+		}
+	case "enter":
+		var keys []string
+		for _, sp := range subs {
+			if key := service.SubProjectKey(m.Selected, sp); m.SubProjectSelected[key] {
+				keys = append(keys, key)
+			}
+		}
+		// Seçim yoksa imlecin üzerindeki alt projeyi başlat
+		if len(keys) == 0 && m.SubProjectCursor < len(subs) {
+			keys = []string{service.SubProjectKey(m.Selected, subs[m.SubProjectCursor])}
+		}
+		if len(keys) == 0 {
+			return m, nil
+		}
+		plan, skipped := m.Launcher.SubProjectPlan(m.Selected, keys)
+		if len(plan.Steps) == 0 {
+			m.SubProjectErr = fmt.Errorf("seçilen alt projelerin başlatma komutu yok: %s", strings.Join(skipped, ", "))
+			return m, nil
+		}
+		m.SubProjectErr = nil
+
+		// Seçimi proje bazında hatırla
+		if m.Config.ProjectOverrides == nil {
+			m.Config.ProjectOverrides = make(map[string]domain.ProjectOverride)
+		}
+		pathKey := strings.ToLower(m.Selected.Path)
+		override := m.Config.ProjectOverrides[pathKey]
+		override.SubProjects = keys
+		m.Config.ProjectOverrides[pathKey] = override
+		_ = config.SaveConfig(m.Config)
+
+		m.State = StateProjectActions
+		m.updateLastOpened(m.Selected.Path)
+		cmd := m.startOrderedLaunch(m.Selected, plan)
+		if len(skipped) > 0 && m.LaunchNotice == "" {
+			m.LaunchNotice = "Başlatma komutu olmadığı için atlandı: " + strings.Join(skipped, ", ")
+		}
+		return m, cmd
+	}
+	return m, nil
+}
+
+func (m *MainModel) subProjectsView() string {
+	var b strings.Builder
+	b.WriteString("\n" + HeaderStyle.Render("📦 ALT PROJELER") + "\n")
+	b.WriteString(lipgloss.NewStyle().Foreground(ColorGrey).Render(strings.Repeat("─", 40)) + "\n")
+
+	greyStyle := lipgloss.NewStyle().Foreground(ColorGrey)
+	lastSide := ""
+	for i, sp := range service.SubProjects(m.Selected) {
+		side := "⚙️  Backend"
+		if sp.IsFrontend {
+			side = "🖥️  Frontend"
+		}
+		if side != lastSide {
+			b.WriteString("\n" + lipgloss.NewStyle().Foreground(ColorCyan).Render(side) + "\n")
+			lastSide = side
+		}
+
+		cursor := "  "
+		nameStyle := lipgloss.NewStyle().Foreground(ColorWhite)
+		if i == m.SubProjectCursor {
+			cursor = lipgloss.NewStyle().Foreground(ColorPurple).Render("▸ ")
+			nameStyle = nameStyle.Foreground(ColorPurple).Bold(true)
+		}
+		check := "[ ]"
+		if m.SubProjectSelected[service.SubProjectKey(m.Selected, sp)] {
+			check = lipgloss.NewStyle().Foreground(ColorGreen).Render("[✓]")
+		}
+
+		cmd := sp.StartCmd
+		if cmd == "" {
+			cmd = "(başlatma komutu yok)"
+		}
+		if sp.PackageName != "" && sp.PackageName != sp.Name {
+			cmd = sp.PackageName + " · " + cmd
+		}
+		b.WriteString(fmt.Sprintf("%s%s %s %s %s\n",
+			cursor, check,
+			nameStyle.Width(24).Render(sp.Name),
+			ValueStyle.Width(18).Render(fmt.Sprintf("%s %s", sp.Type, sp.Version)),
+			greyStyle.Render(cmd),
+		))
+	}
+
+	if m.SubProjectErr != nil {
+		b.WriteString("\n" + lipgloss.NewStyle().Foreground(ColorRed).Render("⚠️  "+m.SubProjectErr.Error()) + "\n")
+	}
+	b.WriteString("\n" + greyStyle.Render("Seçim proje bazında hatırlanır. Backend'ler frontend'lerden önce başlatılır.") + "\n")
+
+	content := lipgloss.NewStyle().PaddingLeft(2).Render(b.String())
+	footer := m.renderFooter("↑↓", "Seç", "Space", "İşaretle", "a", "Tümü", "Enter", "Başlat", "Esc", "Geri")
+	return content + "\n  " + footer
+}
@@ -0,0 +1,189 @@
+package ui
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"devterminal/pkg/domain"
+	"devterminal/pkg/service"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// depKey doktor satırını seçim için tanımlar (aynı paket farklı dizinlerde olabilir)
+func depKey(d domain.OutdatedDependency) string {
+	return d.Ecosystem + "\x00" + d.Dir + "\x00" + d.Name
+}
+
+// canUpgrade doktor tablosunda yükseltme seçimi yapılabilir mi (sadece online rapor)
+func (m *MainModel) canUpgrade() bool {
+	return !m.DoctorLoading && !m.DoctorOffline && m.DoctorReport != nil && len(m.DoctorReport.Deps) > 0
+}
+
+// toggleDoctorSelection imleçteki paketi seçer veya seçimi kaldırır
+func (m *MainModel) toggleDoctorSelection() {
+	deps := m.visibleDeps()
+	i := m.Table.Cursor()
+	if i < 0 || i >= len(deps) {
+		return
+	}
+	key := depKey(deps[i])
+	m.DoctorSelected[key] = !m.DoctorSelected[key]
+	m.refreshDoctorRows()
+}
+
+// selectAllDeps görünen paketlerin hepsi seçiliyse temizler, değilse hepsini seçer
+func (m *MainModel) selectAllDeps() {
+	deps := m.visibleDeps()
+	all := true
+	for _, d := range deps {
+		all = all && m.DoctorSelected[depKey(d)]
+	}
+	for _, d := range deps {
+		m.DoctorSelected[depKey(d)] = !all
+	}
+	m.refreshDoctorRows()
+}
+
+// selectedCount görünen seçili paket sayısı
+func (m *MainModel) selectedCount() int {
+	n := 0
+	for _, d := range m.visibleDeps() {
+		if m.DoctorSelected[depKey(d)] {
+			n++
+		}
+	}
+	return n
+}
+
+// selectedDeps görünen seçili paketleri, seçim yoksa imleçteki paketi döndürür
+func (m *MainModel) selectedDeps() []domain.OutdatedDependency {
+	visible := m.visibleDeps()
+	var deps []domain.OutdatedDependency
+	for _, d := range visible {
+		if m.DoctorSelected[depKey(d)] {
+			deps = append(deps, d)
+		}
+	}
+	if len(deps) == 0 {
+		if i := m.Table.Cursor(); i >= 0 && i < len(visible) {
+			deps = append(deps, visible[i])
+		}
+	}
+	return deps
+}
+
+// openUpgrade seçili paketler için yükseltme planını hazırlar ve manifest farkını gösterir
+func (m *MainModel) openUpgrade(target service.UpgradeTarget) {
+	m.UpgradeErr = nil
+	u, err := m.Doctor.PlanUpgrade(m.Selected, m.selectedDeps(), target)
+	if err != nil {
+		// Doktor ekranında durum satırı olarak göster
+		m.UpgradeErr = err
+		return
+	}
+	m.Upgrade = u
+	m.State = StateUpgradePreview
+}
+
+// startUpgrade onaylanan yükseltmeyi arka planda çalıştırır ve adım ekranına geçer
+func (m *MainModel) startUpgrade() tea.Cmd {
+	if m.PipelineRun != nil && m.PipelineRun.Running {
+		// Aynı anda tek pipeline
+		m.State = StatePipeline
+		return nil
+	}
+
+	u := m.Upgrade
+	run := &pipelineRun{
+		Key:      pipelineKey(m.Selected.Path, "upgrade"),
+		Pipeline: domain.Pipeline{Name: "⬆️ Yükseltme (" + string(u.Target) + ")"},
+		Units:    u.Units,
+		Steps:    make([]service.PipelineEvent, len(u.Units)),
+		Started:  time.Now(),
+		Running:  true,
+		Upgrade:  u,
+	}
+	for i := range run.Steps {
+		run.Steps[i] = service.PipelineEvent{Unit: i, Status: service.PipelinePending}
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	run.Cancel = cancel
+
+	m.PipelineRun = run
+	m.PipelineCursor = 0
+	m.ScriptViewport = viewport.New(0, 0)
+	m.ScriptFollow = true
+	m.ScriptReturn = StateDependencyDoctor
+	m.State = StatePipeline
+	m.DoctorSelected = make(map[string]bool)
+	m.refreshPipelineViewport()
+
+	ch := make(chan service.PipelineEvent)
+	go func() {
+		defer cancel()
+		_ = m.Doctor.RunUpgrade(ctx, u, ch)
+	}()
+	return tea.Batch(waitPipelineEvent(run.Key, ch), m.ensureProcessTick())
+}
+
+func (m *MainModel) updateUpgradePreview(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.State = StateDependencyDoctor
+		return m, nil
+	case "q":
+		return m, tea.Quit
+	case "w":
+		m.openUpgrade(service.UpgradeWanted)
+	case "l":
+		m.openUpgrade(service.UpgradeLatest)
+	case "enter":
+		return m, m.startUpgrade()
+	}
+	if m.UpgradeErr != nil {
+		m.State = StateDependencyDoctor
+	}
+	return m, nil
+}
+
+func (m *MainModel) upgradePreviewView() string {
+	var b strings.Builder
+	u := m.Upgrade
+	greyStyle := lipgloss.NewStyle().Foreground(ColorGrey)
+	labelStyle := lipgloss.NewStyle().Foreground(ColorCyan)
+
+	b.WriteString("\n" + HeaderStyle.Render("⬆️  YÜKSELTME ÖNİZLEME — "+m.Selected.Name+" › "+string(u.Target)) + "\n")
+	b.WriteString(greyStyle.Render(strings.Repeat("─", 40)) + "\n")
+
+	for _, plan := range u.Plans {
+		b.WriteString(lipgloss.NewStyle().Foreground(ColorPurple).Bold(true).Render("▶ "+ecosystemIcon(plan.Ecosystem)+" "+plan.Manifest) + "\n")
+		for _, line := range plan.Diff {
+			style := lipgloss.NewStyle().Foreground(ColorGreen)
+			if strings.HasPrefix(line, "-") {
+				style = lipgloss.NewStyle().Foreground(ColorRed)
+			}
+			b.WriteString("  " + style.Render(line) + "\n")
+		}
+		b.WriteString(greyStyle.Render("  Yedeklenecek: "+strings.Join(plan.Files, ", ")) + "\n\n")
+	}
+
+	b.WriteString(labelStyle.Render("Çalışacak komutlar:") + "\n")
+	for _, unit := range u.Units[:u.Rollback] {
+		b.WriteString("  " + ValueStyle.Render(unit.Name) + "\n")
+	}
+	if len(u.Verify) == 0 {
+		b.WriteString(lipgloss.NewStyle().Foreground(ColorYellow).Render("  ⚠️  Doğrulama komutu yok (doctor.verify veya \"test\" scripti tanımlayın)") + "\n")
+	}
+	b.WriteString(greyStyle.Render("  Bir adım başarısız olursa dosyalar geri yüklenir ve kurulum tekrar çalıştırılır.") + "\n\n")
+
+	for _, s := range u.Skipped {
+		b.WriteString(lipgloss.NewStyle().Foreground(ColorYellow).Render("⚠️  Atlandı: "+s) + "\n")
+	}
+
+	content := lipgloss.NewStyle().PaddingLeft(2).Render(b.String())
+	return content + "\n  " + m.renderFooter("w/l", "Wanted/Latest", "Enter", "Uygula", "Esc", "Geri")
+}
@@ -4,6 +4,8 @@ import (
 	"fmt"
 	"strings"
 
+	"devterminal/pkg/domain"
+
 	"github.com/charmbracelet/lipgloss"
 )
 
@@ -266,7 +268,7 @@ func (m *MainModel) actionsView() string {
 			if i == 0 {
 				prefix = "→ " // Ana proje
 			}
-			subStr := fullRowStyle.Render(fmt.Sprintf("%s%s %s: %s", prefix, getTechIcon(string(sub.Type)), sub.Name, ValueStyle.Render(sub.Version)))
+			subStr := fullRowStyle.Render(fmt.Sprintf("%s%s %s: %s", prefix, getTechIcon(string(sub.Type)), subProjectLabel(sub), ValueStyle.Render(sub.Version)))
 			monorepoRows = append(monorepoRows, lipgloss.NewStyle().Foreground(borderColor).Render("│")+subStr+lipgloss.NewStyle().Foreground(borderColor).Render("│"))
 		}
 
@@ -276,7 +278,7 @@ func (m *MainModel) actionsView() string {
 			if i == 0 {
 				prefix = "→ " // Ana proje
 			}
-			subStr := fullRowStyle.Render(fmt.Sprintf("%s%s %s: %s", prefix, getTechIcon(string(sub.Type)), sub.Name, ValueStyle.Render(sub.Version)))
+			subStr := fullRowStyle.Render(fmt.Sprintf("%s%s %s: %s", prefix, getTechIcon(string(sub.Type)), subProjectLabel(sub), ValueStyle.Render(sub.Version)))
 			monorepoRows = append(monorepoRows, lipgloss.NewStyle().Foreground(borderColor).Render("│")+subStr+lipgloss.NewStyle().Foreground(borderColor).Render("│"))
 		}
 	}
@@ -333,7 +335,45 @@ func (m *MainModel) actionsView() string {
 	b.WriteString(lipgloss.NewStyle().Foreground(ColorGrey).Render("───────────────────────") + "\n")
 	b.WriteString("[1] 🖥️  Sadece Frontend\n")
 	b.WriteString("[2] ⚙️  Sadece Backend\n")
-	b.WriteString("[3] 🔥  Full Stack (İkisi Bir Arada)\n\n")
+	b.WriteString("[3] 🔥  Full Stack (İkisi Bir Arada)\n")
+	target := "Terminal Sekmesi (" + m.Launcher.Terminal.Name() + ")"
+	if m.Config.EmbeddedLaunch {
+		target = "Gömülü (Supervisor)"
+	}
+	b.WriteString(fmt.Sprintf("[G] 🧩  Başlatma Hedefi: %s\n", ValueStyle.Render(target)))
+	b.WriteString("[V] 🔍  Komut Önizleme (Dry-Run)\n")
+	if m.DryRun {
+		b.WriteString(lipgloss.NewStyle().Foreground(ColorYellow).Render("    🧪 --dry-run açık: başlatma komutları çalıştırılmaz, sadece önizlenir") + "\n")
+	}
+	if m.LaunchErr != nil {
+		b.WriteString(lipgloss.NewStyle().Foreground(ColorRed).Render("    ⚠️  "+m.LaunchErr.Error()+" ([V] ile inceleyin)") + "\n")
+	}
+	running := 0
+	for _, mp := range m.Launcher.Supervisor.Processes() {
+		if mp.Running() {
+			running++
+		}
+	}
+	b.WriteString(fmt.Sprintf("[S] 📟  Süreçler & Loglar (%d çalışıyor)\n", running))
+	if len(p.Services) > 0 {
+		b.WriteString(fmt.Sprintf("[8] 🧱  Servisler (%d tanımlı)\n", len(p.Services)))
+	} else if p.ManifestError != "" {
+		b.WriteString(lipgloss.NewStyle().Foreground(ColorRed).Render("[8] 🧱  Servisler (manifest hatalı)") + "\n")
+	}
+	if subs := len(p.AllFrontends) + len(p.AllBackends); subs > 0 {
+		label := fmt.Sprintf("[9] 📦  Alt Projeleri Başlat (%d alt proje)", subs)
+		if saved := len(m.savedSubProjects()); saved > 0 {
+			label = fmt.Sprintf("[9] 📦  Alt Projeleri Başlat (%d/%d seçili)", saved, subs)
+		}
+		b.WriteString(label + "\n")
+	}
+	b.WriteString("\n")
+
+	// 1.4. Sıralı başlatma ilerlemesi (readiness probe'ları)
+	b.WriteString(m.launchProgressSection())
+
+	// 1.5. Projenin gömülü süreçleri (yeniden başlatma sayısı ve son çıkış nedeni)
+	b.WriteString(m.projectProcessesSection())
 
 	// 2. Uzak Erişim
 	b.WriteString(HeaderStyle.Render("🌍 UZAK ERİŞİM") + "\n")
@@ -351,34 +391,28 @@ func (m *MainModel) actionsView() string {
 	}
 
 	b.WriteString("[6] 🩺  Dependency Doctor (Paket Güncelle)\n")
+	b.WriteString("[A] 🛡️  Güvenlik Taraması (Audit)\n")
+	b.WriteString("[I] 🔎  Import Analizi (Kullanılmayan Paketler)\n")
+	b.WriteString("[O] 📦  SBOM Oluştur (CycloneDX / SPDX)\n")
 	b.WriteString("[H] 🏥  Sağlık Skoru Hesapla\n")
 	b.WriteString("[E] 📂  Explorer'da Aç\n")
+	b.WriteString("[0] 🕘  Çalıştırma Geçmişi\n")
 
-	// 3.5. Task Runner (Scriptler varsa)
-	if len(m.Selected.Scripts) > 0 {
-		b.WriteString("[7] 📜  Script Çalıştır (Task Runner)\n")
+	// 3.5. Task Runner (Görevler varsa)
+	if len(m.Selected.Tasks) > 0 || len(m.Launcher.Pipelines(m.Selected)) > 0 {
+		b.WriteString("[7] 📜  Görev Çalıştır (Task Runner)\n")
 	}
 	b.WriteString("\n")
 
-	// 4. Veritabanı Araçları (sadece varsa göster)
-	hasDbTools := m.Selected.HasPrisma || m.Selected.HasDrizzle || m.Selected.HasHasura || m.Selected.HasSupabase || m.Selected.HasStorybook
-	if hasDbTools {
+	// 4. Veritabanı & UI Araçları (araç kaydında tespit edilenler)
+	if len(m.Selected.Tools) > 0 || len(m.Selected.ToolWarnings) > 0 {
 		b.WriteString(HeaderStyle.Render("🧠 VERİTABANI & UI ARAÇLARI") + "\n")
 		b.WriteString(lipgloss.NewStyle().Foreground(ColorGrey).Render("───────────────────────") + "\n")
-		if m.Selected.HasPrisma {
-			b.WriteString("[F1] ◮  Prisma Studio\n")
+		for _, t := range m.Selected.Tools {
+			b.WriteString("[" + strings.ToUpper(t.Key) + "] " + t.Icon + "  " + t.Name + "\n")
 		}
-		if m.Selected.HasDrizzle {
-			b.WriteString("[F2] 🌧️  Drizzle Studio\n")
-		}
-		if m.Selected.HasHasura {
-			b.WriteString("[F3] 🦅  Hasura Console\n")
-		}
-		if m.Selected.HasSupabase {
-			b.WriteString("[F4] ⚡  Supabase Status\n")
-		}
-		if m.Selected.HasStorybook {
-			b.WriteString("[F5] 📕  Storybook (UI Dev)\n")
+		for _, w := range m.Selected.ToolWarnings {
+			b.WriteString(lipgloss.NewStyle().Foreground(ColorRed).Render("⚠️  "+w) + "\n")
 		}
 		b.WriteString("\n")
 	}
@@ -408,6 +442,14 @@ func (m *MainModel) actionsView() string {
 	return content
 }
 
+// subProjectLabel alt proje adını, klasör adından farklıysa paket adıyla birlikte yazar
+func subProjectLabel(sub domain.SubProject) string {
+	if sub.PackageName == "" || sub.PackageName == sub.Name {
+		return sub.Name
+	}
+	return sub.Name + lipgloss.NewStyle().Foreground(ColorGrey).Render(" ("+sub.PackageName+")")
+}
+
 func (m *MainModel) dashboardView() string {
 	return ""
 }
@@ -450,6 +492,10 @@ func (m *MainModel) taskRunnerView() string {
 	listView = strings.Replace(listView, "Nothing matched", "Sonuç bulunamadı", 1)
 	doc.WriteString(listView)
 
+	if m.PipelineErr != nil {
+		doc.WriteString("\n  " + lipgloss.NewStyle().Foreground(ColorRed).Render("❌ "+m.PipelineErr.Error()))
+	}
+
 	return doc.String()
 }
 